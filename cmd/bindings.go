@@ -0,0 +1,1763 @@
+// Code generated by bindgen. DO NOT EDIT.
+
+package main
+
+import (
+	"reflect"
+	"syscall/js"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// AddShape provides the method to add shape in a sheet by given worksheet name
+// and shape format set (such as offset, scale, aspect ratio setting and print
+// settings).
+func AddShape(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Shape{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.Shape)
+		if err := f.AddShape(args[0].String(), &opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// AddSparkline provides a function to add sparklines to the worksheet by given
+// formatting options. Sparklines are small charts that fit in a single cell and
+// are used to show trends in data. Sparklines are a feature of Excel 2010 and
+// later only. You can write them to workbook that can be read by Excel 2007,
+// but they won't be displayed. For example, add a grouped sparkline.
+func AddSparkline(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.SparklineOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.SparklineOptions)
+		if err := f.AddSparkline(args[0].String(), &opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// AddTable provides the method to add table in a worksheet by given worksheet
+// name, range reference and format set.
+func AddTable(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Table{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		table := goVal.Elem().Interface().(excelize.Table)
+		if err := f.AddTable(args[0].String(), &table); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// AutoFitColWidth provides a function to auto fit columns width according to
+// their text content with font format. If the selected range contains hidden
+// columns and those columns have content, this function will unhide the hidden
+// columns. Note that this function calculates the width of the text
+// approximately based on the font format, currently does not support merged
+// cells, the actual width may be different when you open the workbook in Office
+// applications. This process can be relatively slow on large worksheets, so
+// this should normally only be called once per column, at the end of your
+// processing.
+func AutoFitColWidth(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.AutoFitColWidth(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// CopySheet provides a function to duplicate a worksheet by gave source and
+// target worksheet index. Note that currently doesn't support duplicate
+// workbooks that contain tables, charts or pictures.
+func CopySheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.CopySheet(args[0].Int(), args[1].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeleteChart provides a function to delete chart in spreadsheet by given
+// worksheet name and cell reference.
+func DeleteChart(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DeleteChart(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeleteComment provides the method to delete comment in a worksheet by given
+// worksheet name and cell reference.
+func DeleteComment(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DeleteComment(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeleteDefinedName provides a function to delete the defined names of the
+// workbook or worksheet. If not specified scope, the default scope is workbook.
+func DeleteDefinedName(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.DefinedName{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		definedName := goVal.Elem().Interface().(excelize.DefinedName)
+		if err := f.DeleteDefinedName(&definedName); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeleteFormControl provides the method to delete form control in a worksheet
+// by given worksheet name and cell reference.
+func DeleteFormControl(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DeleteFormControl(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeletePicture provides a function to delete all pictures in a cell by given
+// worksheet name and cell reference.
+func DeletePicture(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DeletePicture(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeletePivotTable delete a pivot table by giving the worksheet name and pivot
+// table name. Note that this function does not clean cell values in the pivot
+// table range.
+func DeletePivotTable(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DeletePivotTable(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeleteSheet provides a function to delete worksheet in a workbook by given
+// worksheet name. Use this method with caution, which will affect changes in
+// references such as formulas, charts, and so on. If there is any referenced
+// value of the deleted worksheet, it will cause a file error when you open it.
+// This function will be invalid when only one worksheet is left.
+func DeleteSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DeleteSheet(args[0].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeleteSlicer provides the method to delete a slicer by a given slicer name.
+func DeleteSlicer(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DeleteSlicer(args[0].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DeleteTable provides the method to delete table by given table name.
+func DeleteTable(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DeleteTable(args[0].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DuplicateRow inserts a copy of specified row (by its Excel row number) below
+func DuplicateRow(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DuplicateRow(args[0].String(), args[1].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// DuplicateRowTo inserts a copy of specified row by it Excel number to
+// specified row position moving down exists rows after target position
+func DuplicateRowTo(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.DuplicateRowTo(args[0].String(), args[1].Int(), args[2].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetActiveSheetIndex provides a function to get active sheet index of the
+// spreadsheet. If not found the active sheet will be return integer 0.
+func GetActiveSheetIndex(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"index": 0, "error": nil}
+		if err := prepareArgs(args, []argsRule{}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var err error
+		if ret["index"], err = goResultToJS(f.GetActiveSheetIndex(), fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetCellFormula provides a function to get formula from cell by given
+// worksheet name and cell reference in spreadsheet.
+func GetCellFormula(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"formula": "", "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		formula, err := f.GetCellFormula(args[0].String(), args[1].String())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["formula"], err = goResultToJS(formula, fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetCellStyle provides a function to get cell style index by given worksheet
+// name and cell reference. This function is concurrency safe.
+func GetCellStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"style": 0, "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		style, err := f.GetCellStyle(args[0].String(), args[1].String())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["style"], err = goResultToJS(style, fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetColOutlineLevel provides a function to get outline level of a single
+// column by given worksheet name and column name.
+func GetColOutlineLevel(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"level": 0, "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		level, err := f.GetColOutlineLevel(args[0].String(), args[1].String())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["level"], err = goResultToJS(level, fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetColStyle provides a function to get column style ID by given worksheet
+// name and column name. This function is concurrency safe.
+func GetColStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"style": 0, "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		style, err := f.GetColStyle(args[0].String(), args[1].String())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["style"], err = goResultToJS(style, fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetColVisible provides a function to get visible of a single column by given
+// worksheet name and column name. This function is concurrency safe.
+func GetColVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"visible": false, "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		visible, err := f.GetColVisible(args[0].String(), args[1].String())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["visible"], err = goResultToJS(visible, fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetRowOutlineLevel provides a function to get outline level number of a
+// single row by given worksheet name and Excel row number.
+func GetRowOutlineLevel(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"level": 0, "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		level, err := f.GetRowOutlineLevel(args[0].String(), args[1].Int())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["level"], err = goResultToJS(level, fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetRowVisible provides a function to get visible of a single row by given
+// worksheet name and Excel row number.
+func GetRowVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"visible": false, "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		visible, err := f.GetRowVisible(args[0].String(), args[1].Int())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["visible"], err = goResultToJS(visible, fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetSheetIndex provides a function to get a sheet index of the workbook by the
+// given sheet name. If the given sheet name is invalid or sheet doesn't exist,
+// it will return an integer type value -1.
+func GetSheetIndex(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"index": 0, "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		index, err := f.GetSheetIndex(args[0].String())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["index"], err = goResultToJS(index, fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetSheetName provides a function to get the sheet name of the workbook by the
+// given sheet index. If the given sheet index is invalid, it will return an
+// empty string.
+func GetSheetName(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"name": "", "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var err error
+		if ret["name"], err = goResultToJS(f.GetSheetName(args[0].Int()), fileStates[f].bigInt); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// InsertCols provides a function to insert new columns before the given column
+// name and number of columns.
+func InsertCols(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.InsertCols(args[0].String(), args[1].String(), args[2].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// InsertPageBreak create a page break to determine where the printed page ends
+// and where begins the next one by given worksheet name and cell reference, so
+// the content before the page break will be printed on one page and after the
+// page break on another.
+func InsertPageBreak(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.InsertPageBreak(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// InsertRows provides a function to insert new rows after the given Excel row
+// number starting from 1 and number of rows.
+func InsertRows(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.InsertRows(args[0].String(), args[1].Int(), args[2].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// MergeCell provides a function to merge cells by given range reference and
+// sheet name. Merging cells only keeps the upper-left cell value, and discards
+// the other values.
+func MergeCell(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.MergeCell(args[0].String(), args[1].String(), args[2].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// MoveSheet moves a sheet to a specified position in the workbook. The function
+// moves the source sheet before the target sheet. After moving, other sheets
+// will be shifted to the left or right. If the sheet is already at the target
+// position, the function will not perform any action. Not that this function
+// will be ungroup all sheets after moving.
+func MoveSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.MoveSheet(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// ProtectSheet provides a function to prevent other users from accidentally or
+// deliberately changing, moving, or deleting data in a worksheet. The optional
+// field AlgorithmName specified hash algorithm, support XOR, MD4, MD5, SHA-1,
+// SHA2-56, SHA-384, and SHA-512 currently, if no hash algorithm specified, will
+// be using the XOR algorithm as default.
+func ProtectSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.SheetProtectionOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.SheetProtectionOptions)
+		if err := f.ProtectSheet(args[0].String(), &opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// ProtectWorkbook provides a function to prevent other users from viewing
+// hidden worksheets, adding, moving, deleting, or hiding worksheets, and
+// renaming worksheets in a workbook. The optional field AlgorithmName specified
+// hash algorithm, support XOR, MD4, MD5, SHA-1, SHA2-56, SHA-384, and SHA-512
+// currently, if no hash algorithm specified, will be using the XOR algorithm as
+// default. The generated workbook only works on Microsoft Office 2007 and
+// later.
+func ProtectWorkbook(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.WorkbookProtectionOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.WorkbookProtectionOptions)
+		if err := f.ProtectWorkbook(&opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// RemoveCol provides a function to remove single column by given worksheet name
+// and column index.
+func RemoveCol(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.RemoveCol(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// RemovePageBreak remove a page break by given worksheet name and cell
+// reference.
+func RemovePageBreak(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.RemovePageBreak(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// RemoveRow provides a function to remove single row by given worksheet name
+// and Excel row number.
+func RemoveRow(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.RemoveRow(args[0].String(), args[1].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetActiveSheet provides a function to set the default active sheet of the
+// workbook by a given index. Note that the active index is different from the
+// ID returned by function GetSheetMap(). It should be greater than or equal to
+// 0 and less than the total worksheet numbers.
+func SetActiveSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		f.SetActiveSheet(args[0].Int())
+		return js.ValueOf(ret)
+	}
+}
+
+// SetAppProps provides a function to set document application properties.
+func SetAppProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.AppProperties{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		appProperties := goVal.Elem().Interface().(excelize.AppProperties)
+		if err := f.SetAppProps(&appProperties); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCalcProps provides a function to sets calculation properties. Optional
+// value of "CalcMode" property is: "manual", "auto" or "autoNoTable". Optional
+// value of "RefMode" property is: "A1" or "R1C1".
+func SetCalcProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.CalcPropsOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.CalcPropsOptions)
+		if err := f.SetCalcProps(&opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellBool provides a function to set bool type value of a cell by given
+// worksheet name, cell reference and cell value.
+func SetCellBool(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeBoolean}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetCellBool(args[0].String(), args[1].String(), args[2].Bool()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellDefault provides a function to set string type value of a cell as
+// default format without escaping the cell.
+func SetCellDefault(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetCellDefault(args[0].String(), args[1].String(), args[2].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellFloat sets a floating point value into a cell. The precision parameter
+// specifies how many places after the decimal will be shown while -1 is a
+// special value that will use as many decimal places as necessary to represent
+// the number. bitSize is 32 or 64 depending on if a float32 or float64 was
+// originally used for the value.
+func SetCellFloat(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetCellFloat(args[0].String(), args[1].String(), args[2].Float(), args[3].Int(), args[4].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellFormula provides a function to set formula on the cell is taken
+// according to the given worksheet name and cell formula settings. The result
+// of the formula cell can be calculated when the worksheet is opened by the
+// Office Excel application or can be using the "CalcCellValue" function also
+// can get the calculated cell value. If the Excel application doesn't calculate
+// the formula automatically when the workbook has been opened, please call
+// "UpdateLinkedValue" after setting the cell formula functions.
+func SetCellFormula(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}, opts: true},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var opts []excelize.FormulaOpts
+		if len(args) > 3 {
+			goVal, err := jsValueToGo(args[3], reflect.TypeOf(excelize.FormulaOpts{}), fileStates[f].strict)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = append(opts, goVal.Elem().Interface().(excelize.FormulaOpts))
+		}
+		if err := f.SetCellFormula(args[0].String(), args[1].String(), args[2].String(), opts...); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellHyperLink provides a function to set cell hyperlink by given worksheet
+// name and link URL address. LinkType defines three types of hyperlink
+// "External" for website or "Location" for moving to one of cell in this
+// workbook or "None" for remove hyperlink. Maximum limit hyperlinks in a
+// worksheet is 65530. This function is only used to set the hyperlink of the
+// cell and doesn't affect the value of the cell. If you need to set the value
+// of the cell, please use the other functions such as `SetCellStyle` or
+// `SetSheetRow`. The below is example for external link.
+func SetCellHyperLink(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}, opts: true},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var opts []excelize.HyperlinkOpts
+		if len(args) > 4 {
+			goVal, err := jsValueToGo(args[4], reflect.TypeOf(excelize.HyperlinkOpts{}), fileStates[f].strict)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = append(opts, goVal.Elem().Interface().(excelize.HyperlinkOpts))
+		}
+		if err := f.SetCellHyperLink(args[0].String(), args[1].String(), args[2].String(), args[3].String(), opts...); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellInt provides a function to set int type value of a cell by given
+// worksheet name, cell reference and cell value.
+func SetCellInt(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber, jsTypeBigInt}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var value int64
+		if jsType(args[2]) == jsTypeBigInt {
+			var err error
+			if value, err = jsBigIntToInt64(args[2]); err != nil {
+				setError(ret, args, &argError{err: err, index: 2})
+				return js.ValueOf(ret)
+			}
+		} else {
+			value = int64(args[2].Int())
+		}
+		if err := f.SetCellInt(args[0].String(), args[1].String(), value); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellStr provides a function to set string type value of a cell. Total
+// number of characters that a cell can contain 32767 characters.
+func SetCellStr(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetCellStr(args[0].String(), args[1].String(), args[2].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellStyle provides a function to add style attribute for cells by given
+// worksheet name, range reference and style ID. This function is concurrency
+// safe. Note that diagonalDown and diagonalUp type border should be use same
+// color in the same range. SetCellStyle will overwrite the existing styles for
+// the cell, it won't append or merge style with existing styles.
+func SetCellStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetCellStyle(args[0].String(), args[1].String(), args[2].String(), args[3].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetCellUint provides a function to set uint type value of a cell by given
+// worksheet name, cell reference and cell value.
+func SetCellUint(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber, jsTypeBigInt}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var value uint64
+		if jsType(args[2]) == jsTypeBigInt {
+			var err error
+			if value, err = jsBigIntToUint64(args[2]); err != nil {
+				setError(ret, args, &argError{err: err, index: 2})
+				return js.ValueOf(ret)
+			}
+		} else {
+			if args[2].Float() < 0 {
+				setError(ret, args, &argError{err: errArgType, index: 2})
+				return js.ValueOf(ret)
+			}
+			value = uint64(args[2].Float())
+		}
+		if err := f.SetCellUint(args[0].String(), args[1].String(), value); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetColOutlineLevel provides a function to set outline level of a single
+// column by given worksheet name and column name. The value of parameter
+// 'level' is 1-7.
+func SetColOutlineLevel(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetColOutlineLevel(args[0].String(), args[1].String(), uint8(args[2].Int())); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetColStyle provides a function to set style of columns by given worksheet
+// name, columns range and style ID. This function is concurrency safe. Note
+// that this will overwrite the existing styles for the columns, it won't append
+// or merge style with existing styles.
+func SetColStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetColStyle(args[0].String(), args[1].String(), args[2].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetColVisible provides a function to set visible columns by given worksheet
+// name, columns range and visibility. This function is concurrency safe.
+func SetColVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeBoolean}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetColVisible(args[0].String(), args[1].String(), args[2].Bool()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetColWidth provides a function to set the width of a single column or
+// multiple columns. This function is concurrency safe.
+func SetColWidth(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetColWidth(args[0].String(), args[1].String(), args[2].String(), args[3].Float()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetDefaultFont changes the default font in the workbook.
+func SetDefaultFont(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetDefaultFont(args[0].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetDefinedName provides a function to set the defined names of the workbook
+// or worksheet. If not specified scope, the default scope is workbook.
+func SetDefinedName(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.DefinedName{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		definedName := goVal.Elem().Interface().(excelize.DefinedName)
+		if err := f.SetDefinedName(&definedName); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetHeaderFooter provides a function to set headers and footers by given
+// worksheet name and the control characters.
+func SetHeaderFooter(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.HeaderFooterOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.HeaderFooterOptions)
+		if err := f.SetHeaderFooter(args[0].String(), &opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetPageLayout provides a function to sets worksheet page layout.
+func SetPageLayout(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.PageLayoutOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.PageLayoutOptions)
+		if err := f.SetPageLayout(args[0].String(), &opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetPageMargins provides a function to set worksheet page margins.
+func SetPageMargins(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.PageLayoutMarginsOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.PageLayoutMarginsOptions)
+		if err := f.SetPageMargins(args[0].String(), &opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetPanes provides a function to create and remove freeze panes and split
+// panes by given worksheet name and panes options.
+func SetPanes(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Panes{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		panes := goVal.Elem().Interface().(excelize.Panes)
+		if err := f.SetPanes(args[0].String(), &panes); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetRowHeight provides a function to set the height of a single row. If the
+// value of height is 0, will hide the specified row, if the value of height is
+// -1, will unset the custom row height.
+func SetRowHeight(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetRowHeight(args[0].String(), args[1].Int(), args[2].Float()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetRowOutlineLevel provides a function to set outline level number of a
+// single row by given worksheet name and row number. The range of 'level'
+// parameter value from 1 to 7.
+func SetRowOutlineLevel(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetRowOutlineLevel(args[0].String(), args[1].Int(), uint8(args[2].Int())); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetRowStyle provides a function to set the style of rows by given worksheet
+// name, row range, and style ID. Note that this will overwrite the existing
+// styles for the rows, it won't append or merge style with existing styles.
+func SetRowStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetRowStyle(args[0].String(), args[1].Int(), args[2].Int(), args[3].Int()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetRowVisible provides a function to set visible of a single row by given
+// worksheet name and Excel row number.
+func SetRowVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeBoolean}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetRowVisible(args[0].String(), args[1].Int(), args[2].Bool()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetSheetDimension provides the method to set or remove the used range of the
+// worksheet by a given range reference. It specifies the row and column bounds
+// of used cells in the worksheet. The range reference is set using the A1
+// reference style(e.g., "A1:D5"). Passing an empty range reference will remove
+// the used range of the worksheet.
+func SetSheetDimension(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetSheetDimension(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetSheetName provides a function to set the worksheet name by given source
+// and target worksheet names. Maximum 31 characters are allowed in sheet title
+// and this function only changes the name of the sheet and will not update the
+// sheet name in the formula or reference associated with the cell. So there may
+// be problem formula error or reference missing.
+func SetSheetName(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.SetSheetName(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetSheetProps provides a function to set worksheet properties.
+func SetSheetProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.SheetPropsOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.SheetPropsOptions)
+		if err := f.SetSheetProps(args[0].String(), &opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetSheetView sets sheet view options. The viewIndex may be negative and if so
+// is counted backward (-1 is the last view).
+func SetSheetView(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.ViewOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.ViewOptions)
+		if err := f.SetSheetView(args[0].String(), args[1].Int(), &opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// SetWorkbookProps provides a function to sets workbook properties.
+func SetWorkbookProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.WorkbookPropsOptions{}), fileStates[f].strict)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		opts := goVal.Elem().Interface().(excelize.WorkbookPropsOptions)
+		if err := f.SetWorkbookProps(&opts); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// UngroupSheets provides a function to ungroup worksheets.
+func UngroupSheets(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.UngroupSheets(); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// UnmergeCell provides a function to unmerge a given range reference.
+func UnmergeCell(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.UnmergeCell(args[0].String(), args[1].String(), args[2].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// UnsetConditionalFormat provides a function to unset the conditional format by
+// given worksheet name and range reference.
+func UnsetConditionalFormat(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.UnsetConditionalFormat(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// UpdateLinkedValue fix linked values within a spreadsheet are not updating in
+// Office Excel application. This function will be remove value tag when met a
+// cell have a linked value. Reference
+// https://learn.microsoft.com/en-us/archive/msdn-technet-forums/e16bae1f-6a2c-4325-8013-e989a3479066
+func UpdateLinkedValue(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if err := f.UpdateLinkedValue(); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// interopFuncs returns the wrapper functions of the workbook.
+func interopFuncs(f *excelize.File) map[string]func(this js.Value, args []js.Value) interface{} {
+	return map[string]func(this js.Value, args []js.Value) interface{}{
+		"AddChart":                    AddChart(f),
+		"AddChartSheet":               AddChartSheet(f),
+		"AddComment":                  AddComment(f),
+		"AddDataValidation":           AddDataValidation(f),
+		"AddFormControl":              AddFormControl(f),
+		"AddHeaderFooterImage":        AddHeaderFooterImage(f),
+		"AddIgnoredErrors":            AddIgnoredErrors(f),
+		"AddPictureFromBytes":         AddPictureFromBytes(f),
+		"AddPivotTable":               AddPivotTable(f),
+		"AddShape":                    AddShape(f),
+		"AddSlicer":                   AddSlicer(f),
+		"AddSparkline":                AddSparkline(f),
+		"AddTable":                    AddTable(f),
+		"AddVBAProject":               AddVBAProject(f),
+		"AutoFilter":                  AutoFilter(f),
+		"AutoFitColWidth":             AutoFitColWidth(f),
+		"CalcCellValue":               CalcCellValue(f),
+		"CharsetTranscoder":           CharsetTranscoder(f),
+		"Close":                       Close(f),
+		"Cols":                        Cols(f),
+		"CopySheet":                   CopySheet(f),
+		"DeleteChart":                 DeleteChart(f),
+		"DeleteComment":               DeleteComment(f),
+		"DeleteDataValidation":        DeleteDataValidation(f),
+		"DeleteDefinedName":           DeleteDefinedName(f),
+		"DeleteFormControl":           DeleteFormControl(f),
+		"DeletePicture":               DeletePicture(f),
+		"DeletePivotTable":            DeletePivotTable(f),
+		"DeleteSheet":                 DeleteSheet(f),
+		"DeleteSlicer":                DeleteSlicer(f),
+		"DeleteTable":                 DeleteTable(f),
+		"DuplicateRow":                DuplicateRow(f),
+		"DuplicateRowTo":              DuplicateRowTo(f),
+		"ExportCSV":                   ExportCSV(f),
+		"GetActiveSheetIndex":         GetActiveSheetIndex(f),
+		"GetAppProps":                 GetAppProps(f),
+		"GetBaseColor":                GetBaseColor(f),
+		"GetCalcProps":                GetCalcProps(f),
+		"GetCellDate":                 GetCellDate(f),
+		"GetCellFormula":              GetCellFormula(f),
+		"GetCellHyperLink":            GetCellHyperLink(f),
+		"GetCellRichText":             GetCellRichText(f),
+		"GetCellStyle":                GetCellStyle(f),
+		"GetCellType":                 GetCellType(f),
+		"GetCellValue":                GetCellValue(f),
+		"GetColOutlineLevel":          GetColOutlineLevel(f),
+		"GetColStyle":                 GetColStyle(f),
+		"GetColVisible":               GetColVisible(f),
+		"GetColWidth":                 GetColWidth(f),
+		"GetCols":                     GetCols(f),
+		"GetComments":                 GetComments(f),
+		"GetConditionalFormats":       GetConditionalFormats(f),
+		"GetConditionalStyle":         GetConditionalStyle(f),
+		"GetCustomProps":              GetCustomProps(f),
+		"GetDataValidations":          GetDataValidations(f),
+		"GetDefaultFont":              GetDefaultFont(f),
+		"GetDefinedName":              GetDefinedName(f),
+		"GetDocProps":                 GetDocProps(f),
+		"GetFormControls":             GetFormControls(f),
+		"GetHeaderFooter":             GetHeaderFooter(f),
+		"GetHyperLinkCells":           GetHyperLinkCells(f),
+		"GetMergeCells":               GetMergeCells(f),
+		"GetPageLayout":               GetPageLayout(f),
+		"GetPageMargins":              GetPageMargins(f),
+		"GetPanes":                    GetPanes(f),
+		"GetPictureCells":             GetPictureCells(f),
+		"GetPictures":                 GetPictures(f),
+		"GetPivotTables":              GetPivotTables(f),
+		"GetRangeValues":              GetRangeValues(f),
+		"GetRecords":                  GetRecords(f),
+		"GetRowHeight":                GetRowHeight(f),
+		"GetRowOutlineLevel":          GetRowOutlineLevel(f),
+		"GetRowVisible":               GetRowVisible(f),
+		"GetRows":                     GetRows(f),
+		"GetSheetDimension":           GetSheetDimension(f),
+		"GetSheetIndex":               GetSheetIndex(f),
+		"GetSheetList":                GetSheetList(f),
+		"GetSheetMap":                 GetSheetMap(f),
+		"GetSheetName":                GetSheetName(f),
+		"GetSheetProps":               GetSheetProps(f),
+		"GetSheetProtection":          GetSheetProtection(f),
+		"GetSheetView":                GetSheetView(f),
+		"GetSheetVisible":             GetSheetVisible(f),
+		"GetSlicers":                  GetSlicers(f),
+		"GetStyle":                    GetStyle(f),
+		"GetTables":                   GetTables(f),
+		"GetWorkbookProps":            GetWorkbookProps(f),
+		"GroupSheets":                 GroupSheets(f),
+		"ImportCSV":                   ImportCSV(f),
+		"InsertCols":                  InsertCols(f),
+		"InsertPageBreak":             InsertPageBreak(f),
+		"InsertRows":                  InsertRows(f),
+		"MergeCell":                   MergeCell(f),
+		"MoveSheet":                   MoveSheet(f),
+		"NewConditionalStyle":         NewConditionalStyle(f),
+		"NewSheet":                    NewSheet(f),
+		"NewStreamWriter":             NewStreamWriter(f),
+		"NewStyle":                    NewStyle(f),
+		"ProtectSheet":                ProtectSheet(f),
+		"ProtectWorkbook":             ProtectWorkbook(f),
+		"RemoveCol":                   RemoveCol(f),
+		"RemovePageBreak":             RemovePageBreak(f),
+		"RemoveRow":                   RemoveRow(f),
+		"RenderHTML":                  RenderHTML(f),
+		"Rows":                        Rows(f),
+		"SearchSheet":                 SearchSheet(f),
+		"SetActiveSheet":              SetActiveSheet(f),
+		"SetAppProps":                 SetAppProps(f),
+		"SetCalcProps":                SetCalcProps(f),
+		"SetCellBool":                 SetCellBool(f),
+		"SetCellDefault":              SetCellDefault(f),
+		"SetCellFloat":                SetCellFloat(f),
+		"SetCellFormula":              SetCellFormula(f),
+		"SetCellHyperLink":            SetCellHyperLink(f),
+		"SetCellInt":                  SetCellInt(f),
+		"SetCellRichText":             SetCellRichText(f),
+		"SetCellStr":                  SetCellStr(f),
+		"SetCellStyle":                SetCellStyle(f),
+		"SetCellUint":                 SetCellUint(f),
+		"SetCellValue":                SetCellValue(f),
+		"SetCells":                    SetCells(f),
+		"SetColOutlineLevel":          SetColOutlineLevel(f),
+		"SetColStyle":                 SetColStyle(f),
+		"SetColVisible":               SetColVisible(f),
+		"SetColWidth":                 SetColWidth(f),
+		"SetConditionalFormat":        SetConditionalFormat(f),
+		"SetCustomProps":              SetCustomProps(f),
+		"SetDefaultFont":              SetDefaultFont(f),
+		"SetDefinedName":              SetDefinedName(f),
+		"SetDocProps":                 SetDocProps(f),
+		"SetHeaderFooter":             SetHeaderFooter(f),
+		"SetPageLayout":               SetPageLayout(f),
+		"SetPageMargins":              SetPageMargins(f),
+		"SetPanes":                    SetPanes(f),
+		"SetRecords":                  SetRecords(f),
+		"SetRowHeight":                SetRowHeight(f),
+		"SetRowOutlineLevel":          SetRowOutlineLevel(f),
+		"SetRowStyle":                 SetRowStyle(f),
+		"SetRowVisible":               SetRowVisible(f),
+		"SetSheetBackgroundFromBytes": SetSheetBackgroundFromBytes(f),
+		"SetSheetCol":                 SetSheetCol(f),
+		"SetSheetDimension":           SetSheetDimension(f),
+		"SetSheetName":                SetSheetName(f),
+		"SetSheetProps":               SetSheetProps(f),
+		"SetSheetRow":                 SetSheetRow(f),
+		"SetSheetView":                SetSheetView(f),
+		"SetSheetVisible":             SetSheetVisible(f),
+		"SetWorkbookProps":            SetWorkbookProps(f),
+		"UngroupSheets":               UngroupSheets(f),
+		"UnmergeCell":                 UnmergeCell(f),
+		"UnprotectSheet":              UnprotectSheet(f),
+		"UnprotectWorkbook":           UnprotectWorkbook(f),
+		"UnsetConditionalFormat":      UnsetConditionalFormat(f),
+		"UpdateLinkedValue":           UpdateLinkedValue(f),
+		"WriteTo":                     WriteTo(f),
+		"WriteToBuffer":               WriteToBuffer(f),
+	}
+}
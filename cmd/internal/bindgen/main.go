@@ -0,0 +1,811 @@
+// Copyright 2022 - 2026 The excelize-wasm Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Command bindgen generates the bindings of the excelize-wasm. It reads the
+// method set of the excelize.File and the option data types from the excelize
+// source code, and generates the wrapper functions for the methods which
+// arguments and results could be converted directly, the registry of all
+// wrapper functions of the workbook, and the matching TypeScript declarations.
+// The methods which need the special conversion have the hand-written wrapper
+// functions instead, and bindgen reports an error if any of them has no
+// TypeScript declaration. The methods listed in the unboundFuncs will be
+// skipped. Usage:
+//
+//	go generate ./...
+//
+// or run it in the cmd directory:
+//
+//	go run ./internal/bindgen -out bindings.go -dts ../src/index.d.ts
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// excelizePath defined the import path of the excelize package.
+	excelizePath = "github.com/xuri/excelize/v2"
+	// wrapperType defined the signature of the wrapper functions.
+	wrapperType = "func(f *excelize.File) func(this js.Value, args []js.Value) interface{}"
+	// generatedHeader defined the header of the generated Go source code.
+	generatedHeader = "// Code generated by bindgen. DO NOT EDIT."
+	// dtsBegin and dtsEnd defined the markers of the generated code in the
+	// TypeScript declarations.
+	dtsBegin = "// Code generated by bindgen. DO NOT EDIT."
+	dtsEnd   = "// End of code generated by bindgen."
+	// lineWidth defined the maximum width of the doc comments.
+	lineWidth = 80
+	// bigIntConv and bigUintConv defined the conversion of the int64 and
+	// uint64 arguments, which declare the variable of the argument.
+	bigIntConv = `var %[1]s int64
+if jsType(%[2]s) == jsTypeBigInt {
+	var err error
+	if %[1]s, err = jsBigIntToInt64(%[2]s); err != nil {
+		setError(ret, args, &argError{err: err, index: %[3]d})
+		return js.ValueOf(ret)
+	}
+} else {
+	%[1]s = int64(%[2]s.Int())
+}`
+	bigUintConv = `var %[1]s uint64
+if jsType(%[2]s) == jsTypeBigInt {
+	var err error
+	if %[1]s, err = jsBigIntToUint64(%[2]s); err != nil {
+		setError(ret, args, &argError{err: err, index: %[3]d})
+		return js.ValueOf(ret)
+	}
+} else {
+	if %[2]s.Float() < 0 {
+		setError(ret, args, &argError{err: errArgType, index: %[3]d})
+		return js.ValueOf(ret)
+	}
+	%[1]s = uint64(%[2]s.Float())
+}`
+)
+
+var (
+	errUnsupported = errors.New("unsupported data type")
+	// goBasicTypes defined the JavaScript type and the conversion of the
+	// arguments in the Go basic data types, the int64 and uint64 arguments
+	// accept both of the number and BigInt value.
+	goBasicTypes = map[string]basicConv{
+		"bool":    {"js.TypeBoolean", "%s.Bool()", "boolean"},
+		"float32": {"js.TypeNumber", "float32(%s.Float())", "number"},
+		"float64": {"js.TypeNumber", "%s.Float()", "number"},
+		"int":     {"js.TypeNumber", "%s.Int()", "number"},
+		"int8":    {"js.TypeNumber", "int8(%s.Int())", "number"},
+		"int16":   {"js.TypeNumber", "int16(%s.Int())", "number"},
+		"int32":   {"js.TypeNumber", "int32(%s.Int())", "number"},
+		"int64":   {"js.TypeNumber, jsTypeBigInt", bigIntConv, "number | bigint"},
+		"string":  {"js.TypeString", "%s.String()", "string"},
+		"uint":    {"js.TypeNumber", "uint(%s.Int())", "number"},
+		"uint8":   {"js.TypeNumber", "uint8(%s.Int())", "number"},
+		"uint16":  {"js.TypeNumber", "uint16(%s.Int())", "number"},
+		"uint32":  {"js.TypeNumber", "uint32(%s.Int())", "number"},
+		"uint64":  {"js.TypeNumber, jsTypeBigInt", bigUintConv, "number | bigint"},
+	}
+	// paramDocs defined the descriptions of the common parameters in the
+	// TypeScript declarations.
+	paramDocs = map[string]string{
+		"cell":    "The cell reference",
+		"col":     "The column name",
+		"columns": "The column name or range of columns",
+		"opts":    "The options",
+		"ref":     "The range reference",
+		"row":     "The row number",
+		"sheet":   "The worksheet name",
+	}
+	// tsTypeNames defined the names of the excelize data types which have
+	// been declared in the different names in the TypeScript declarations.
+	tsTypeNames = map[string]string{
+		"FormulaOpts": "FormulaOptions",
+		"Table":       "TableOptions",
+	}
+	// reservedNames defined the identifiers used by the generated wrapper
+	// functions, which can't be used as the variable names of arguments.
+	reservedNames = map[string]bool{
+		"args": true, "err": true, "excelize": true, "f": true, "goVal": true,
+		"js": true, "reflect": true, "ret": true, "this": true,
+	}
+)
+
+// basicConv represents the JavaScript type, the conversion format of the
+// argument and the TypeScript data type for a Go basic data type.
+type basicConv struct {
+	jsType, conv, ts string
+}
+
+// excelizePkg represents the parsed excelize package.
+type excelizePkg struct {
+	methods map[string]*ast.FuncDecl
+	types   map[string]*typeDecl
+}
+
+// typeDecl represents the declaration of a named data type in the excelize
+// package.
+type typeDecl struct {
+	spec *ast.TypeSpec
+	doc  string
+}
+
+// param represents an argument of the method.
+type param struct {
+	name     string
+	typ      ast.Expr
+	variadic bool
+}
+
+// binding represents the generated wrapper function and the TypeScript
+// declaration of a method.
+type binding struct {
+	name, goSrc, tsSrc string
+}
+
+// generator represents the state of the bindings generator.
+type generator struct {
+	pkg     *excelizePkg
+	dts     string
+	tsTypes map[string]string
+}
+
+func main() {
+	out := flag.String("out", "bindings.go", "the output Go source file")
+	dts := flag.String("dts", filepath.Join("..", "src", "index.d.ts"), "the TypeScript declarations file")
+	flag.Parse()
+	if err := run(*out, *dts); err != nil {
+		fmt.Fprintln(os.Stderr, "bindgen:", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the bindings to the given Go source file and updates the
+// generated code in the given TypeScript declarations file.
+func run(out, dtsPath string) error {
+	pkg, err := parseExcelize()
+	if err != nil {
+		return err
+	}
+	wrappers, unbound, err := parseWrappers(filepath.Dir(out), filepath.Base(out))
+	if err != nil {
+		return err
+	}
+	dts, err := os.ReadFile(dtsPath)
+	if err != nil {
+		return err
+	}
+	g := &generator{pkg: pkg, dts: string(dts), tsTypes: map[string]string{}}
+	var (
+		bindings []binding
+		missing  []string
+	)
+	for _, name := range sortedKeys(pkg.methods) {
+		if wrappers[name] || unbound[name] {
+			continue
+		}
+		b, err := g.binding(pkg.methods[name])
+		if err != nil {
+			missing = append(missing, fmt.Sprintf("File.%s: %v", name, err))
+			continue
+		}
+		bindings = append(bindings, b)
+		wrappers[name] = true
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no binding for the methods, add the wrapper functions or list them in the unboundFuncs:\n\t%s",
+			strings.Join(missing, "\n\t"))
+	}
+	src, err := g.goSource(bindings, sortedKeys(wrappers))
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(out, src, 0o644); err != nil {
+		return err
+	}
+	if dts, err = g.tsSource(bindings); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(wrappers) {
+		if !strings.Contains(string(dts), "\n    "+name+"(") {
+			missing = append(missing, "File."+name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no TypeScript declaration for the wrapper functions:\n\t%s", strings.Join(missing, "\n\t"))
+	}
+	return os.WriteFile(dtsPath, dts, 0o644)
+}
+
+// parseExcelize parses the source code of the excelize package, and returns
+// the exported methods of the File and the declarations of the data types.
+func parseExcelize() (*excelizePkg, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	bp, err := build.Import(excelizePath, wd, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg := &excelizePkg{methods: map[string]*ast.FuncDecl{}, types: map[string]*typeDecl{}}
+	fset := token.NewFileSet()
+	for _, name := range bp.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(bp.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil || !decl.Name.IsExported() || len(decl.Recv.List) != 1 ||
+					types.ExprString(decl.Recv.List[0].Type) != "*File" {
+					continue
+				}
+				pkg.methods[decl.Name.Name] = decl
+			case *ast.GenDecl:
+				if decl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.TypeSpec)
+					doc := spec.Doc
+					if doc == nil && len(decl.Specs) == 1 {
+						doc = decl.Doc
+					}
+					pkg.types[spec.Name.Name] = &typeDecl{spec: spec, doc: doc.Text()}
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+// parseWrappers parses the Go source code files of the package in the given
+// directory except the generated file, and returns the names of hand-written
+// wrapper functions and the keys of the unboundFuncs.
+func parseWrappers(dir, generated string) (map[string]bool, map[string]bool, error) {
+	wrappers, unbound := map[string]bool{}, map[string]bool{}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return wrappers, unbound, err
+	}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if name := filepath.Base(path); name == generated || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return wrappers, unbound, err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				if n.Recv == nil && types.ExprString(n.Type) == wrapperType {
+					wrappers[n.Name.Name] = true
+				}
+				return false
+			case *ast.ValueSpec:
+				for i, name := range n.Names {
+					if name.Name != "unboundFuncs" || i >= len(n.Values) {
+						continue
+					}
+					if lit, ok := n.Values[i].(*ast.CompositeLit); ok {
+						for _, elt := range lit.Elts {
+							if kv, ok := elt.(*ast.KeyValueExpr); ok {
+								if key, err := strconv.Unquote(types.ExprString(kv.Key)); err == nil {
+									unbound[key] = true
+								}
+							}
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+	return wrappers, unbound, nil
+}
+
+// binding generates the wrapper function and the TypeScript declaration for
+// the given method.
+func (g *generator) binding(fn *ast.FuncDecl) (binding, error) {
+	name := fn.Name.Name
+	var params []param
+	for _, field := range fn.Type.Params.List {
+		typ, variadic := field.Type, false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = ellipsis.Elt, true
+		}
+		for _, ident := range field.Names {
+			params = append(params, param{name: ident.Name, typ: typ, variadic: variadic})
+		}
+	}
+	var (
+		result ast.Expr
+		hasErr bool
+	)
+	if fn.Type.Results != nil {
+		var results []ast.Expr
+		for _, field := range fn.Type.Results.List {
+			for range max(len(field.Names), 1) {
+				results = append(results, field.Type)
+			}
+		}
+		if n := len(results); n > 0 && types.ExprString(results[n-1]) == "error" {
+			results, hasErr = results[:n-1], true
+		}
+		if len(results) > 1 {
+			return binding{}, errUnsupported
+		}
+		if len(results) == 1 {
+			result = results[0]
+		}
+	}
+	var (
+		rules, conv, callArgs, tsParams []string
+		declared                        bool
+	)
+	for i, p := range params {
+		if reservedNames[p.name] {
+			return binding{}, fmt.Errorf("reserved argument name %s", p.name)
+		}
+		arg := fmt.Sprintf("args[%d]", i)
+		tsType, err := g.tsType(p.typ)
+		if err != nil {
+			return binding{}, err
+		}
+		optional := ""
+		if p.variadic {
+			optional = "?"
+		}
+		tsParams = append(tsParams, fmt.Sprintf("%s%s: %s", p.name, optional, tsType))
+		if basic, typeName, ok := g.basicType(p.typ); ok {
+			if p.variadic {
+				return binding{}, errUnsupported
+			}
+			expr := fmt.Sprintf(basic.conv, arg)
+			if strings.Contains(basic.conv, "\n") {
+				conv, expr = append(conv, fmt.Sprintf(basic.conv, p.name, arg, i)), p.name
+			}
+			if typeName != "" {
+				expr = fmt.Sprintf("excelize.%s(%s)", typeName, expr)
+			}
+			rules = append(rules, fmt.Sprintf("{types: []js.Type{%s}},", basic.jsType))
+			callArgs = append(callArgs, expr)
+			continue
+		}
+		typeName, ptr, ok := g.structType(p.typ)
+		if !ok {
+			return binding{}, fmt.Errorf("%w %s", errUnsupported, types.ExprString(p.typ))
+		}
+		if p.variadic {
+			rules = append(rules, "{types: []js.Type{js.TypeObject}, opts: true},")
+			conv = append(conv, fmt.Sprintf(`var %[1]s []excelize.%[2]s
+if len(args) > %[3]d {
+	goVal, err := jsValueToGo(%[4]s, reflect.TypeOf(excelize.%[2]s{}), fileStates[f].strict)
+	if err != nil {
+		setError(ret, args, err)
+		return js.ValueOf(ret)
+	}
+	%[1]s = append(%[1]s, goVal.Elem().Interface().(excelize.%[2]s))
+}`, p.name, typeName, i, arg))
+			callArgs = append(callArgs, p.name+"...")
+			continue
+		}
+		assign := ":="
+		if declared {
+			assign = "="
+		}
+		declared = true
+		rules = append(rules, "{types: []js.Type{js.TypeObject}},")
+		conv = append(conv, fmt.Sprintf(`goVal, err %[4]s jsValueToGo(%[3]s, reflect.TypeOf(excelize.%[2]s{}), fileStates[f].strict)
+if err != nil {
+	setError(ret, args, err)
+	return js.ValueOf(ret)
+}
+%[1]s := goVal.Elem().Interface().(excelize.%[2]s)`, p.name, typeName, arg, assign))
+		if ptr {
+			callArgs = append(callArgs, "&"+p.name)
+		} else {
+			callArgs = append(callArgs, p.name)
+		}
+	}
+	var src strings.Builder
+	src.WriteString(goComment(firstParagraph(fn.Doc.Text()), "// "))
+	fmt.Fprintf(&src, "func %s(f *excelize.File) func(this js.Value, args []js.Value) interface{} {\n", name)
+	src.WriteString("return func(this js.Value, args []js.Value) interface{} {\n")
+	tsResult := "{ error: string | null, errorInfo?: ErrorInfo }"
+	key, defaultValue := "", ""
+	if result != nil {
+		tsType, err := g.tsType(result)
+		if err != nil {
+			return binding{}, err
+		}
+		if defaultValue, err = g.defaultValue(result); err != nil {
+			return binding{}, err
+		}
+		key = resultKey(name)
+		for _, p := range params {
+			if p.name == key {
+				return binding{}, fmt.Errorf("argument name %s conflicts with the result", p.name)
+			}
+		}
+		tsResult = fmt.Sprintf("{ %s: %s, error: string | null, errorInfo?: ErrorInfo }", key, tsType)
+		fmt.Fprintf(&src, "ret := map[string]interface{}{%q: %s, \"error\": nil}\n", key, defaultValue)
+	} else {
+		src.WriteString("ret := map[string]interface{}{\"error\": nil}\n")
+	}
+	fmt.Fprintf(&src, "if err := prepareArgs(args, []argsRule{\n%s\n}); err != nil {\n", strings.Join(rules, "\n"))
+	src.WriteString("setError(ret, args, err)\nreturn js.ValueOf(ret)\n}\n")
+	for _, c := range conv {
+		src.WriteString(c + "\n")
+	}
+	call := fmt.Sprintf("f.%s(%s)", name, strings.Join(callArgs, ", "))
+	switch {
+	case result == nil && !hasErr:
+		src.WriteString(call + "\n")
+	case result == nil:
+		fmt.Fprintf(&src, "if err := %s; err != nil {\nsetError(ret, args, err)\n}\n", call)
+	case !hasErr:
+		if !declared {
+			src.WriteString("var err error\n")
+		}
+		fmt.Fprintf(&src, "if ret[%q], err = goResultToJS(%s, fileStates[f].bigInt); err != nil {\nsetError(ret, args, err)\n}\n", key, call)
+	default:
+		fmt.Fprintf(&src, `%[1]s, err := %[2]s
+if err != nil {
+	setError(ret, args, err)
+	return js.ValueOf(ret)
+}
+if ret[%[1]q], err = goResultToJS(%[1]s, fileStates[f].bigInt); err != nil {
+	setError(ret, args, err)
+}
+`, key, call)
+	}
+	src.WriteString("return js.ValueOf(ret)\n}\n}\n")
+	var ts strings.Builder
+	ts.WriteString("    /**\n")
+	ts.WriteString(goComment(firstParagraph(fn.Doc.Text()), "     * "))
+	for _, p := range params {
+		ts.WriteString(fmt.Sprintf("     * @param %s %s\n", p.name, paramDoc(name, p.name)))
+	}
+	ts.WriteString("     */\n")
+	fmt.Fprintf(&ts, "    %s(%s): %s\n", name, strings.Join(tsParams, ", "), tsResult)
+	return binding{name: name, goSrc: src.String(), tsSrc: ts.String()}, nil
+}
+
+// basicType returns the conversion of the argument in the Go basic data type
+// or the excelize data type defined on the Go basic data type.
+func (g *generator) basicType(expr ast.Expr) (basicConv, string, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return basicConv{}, "", false
+	}
+	if basic, ok := goBasicTypes[ident.Name]; ok {
+		return basic, "", true
+	}
+	if decl, ok := g.pkg.types[ident.Name]; ok && ast.IsExported(ident.Name) {
+		if underlying, ok := decl.spec.Type.(*ast.Ident); ok {
+			if basic, ok := goBasicTypes[underlying.Name]; ok {
+				return basic, ident.Name, true
+			}
+		}
+	}
+	return basicConv{}, "", false
+}
+
+// structType returns the name of the excelize structure data type of the
+// argument, and if the argument is the pointer of the structure.
+func (g *generator) structType(expr ast.Expr) (string, bool, bool) {
+	ptr := false
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr, ptr = star.X, true
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok || !ast.IsExported(ident.Name) {
+		return "", false, false
+	}
+	decl, ok := g.pkg.types[ident.Name]
+	if !ok {
+		return "", false, false
+	}
+	_, ok = decl.spec.Type.(*ast.StructType)
+	return ident.Name, ptr, ok
+}
+
+// defaultValue returns the Go source code of the default value for the given
+// result data type in the result of the wrapper function.
+func (g *generator) defaultValue(expr ast.Expr) (string, error) {
+	if basic, _, ok := g.basicType(expr); ok {
+		switch basic.ts {
+		case "boolean":
+			return "false", nil
+		case "number", "number | bigint":
+			return "0", nil
+		}
+		return `""`, nil
+	}
+	switch expr := expr.(type) {
+	case *ast.ArrayType:
+		return "[]interface{}{}", nil
+	case *ast.MapType, *ast.StarExpr:
+		return "map[string]interface{}{}", nil
+	case *ast.Ident:
+		if _, _, ok := g.structType(expr); ok {
+			return "map[string]interface{}{}", nil
+		}
+	}
+	return "", fmt.Errorf("%w %s", errUnsupported, types.ExprString(expr))
+}
+
+// tsType returns the TypeScript data type for the given Go data type, and
+// generates the declarations of the excelize data types which have not been
+// declared in the TypeScript declarations.
+func (g *generator) tsType(expr ast.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if basic, ok := goBasicTypes[expr.Name]; ok {
+			return basic.ts, nil
+		}
+		if name, ok := tsTypeNames[expr.Name]; ok {
+			return name, nil
+		}
+		decl, ok := g.pkg.types[expr.Name]
+		if !ok || !ast.IsExported(expr.Name) {
+			return "", fmt.Errorf("%w %s", errUnsupported, expr.Name)
+		}
+		return expr.Name, g.declareTSType(expr.Name, decl)
+	case *ast.StarExpr:
+		return g.tsType(expr.X)
+	case *ast.ArrayType:
+		if types.ExprString(expr.Elt) == "byte" {
+			return "Uint8Array", nil
+		}
+		elem, err := g.tsType(expr.Elt)
+		if err != nil {
+			return "", err
+		}
+		return elem + "[]", nil
+	case *ast.MapType:
+		if types.ExprString(expr.Key) != "string" {
+			return "", fmt.Errorf("%w %s", errUnsupported, types.ExprString(expr))
+		}
+		elem, err := g.tsType(expr.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("{ [key: string]: %s }", elem), nil
+	case *ast.SelectorExpr:
+		if types.ExprString(expr) == "time.Time" {
+			return "Date", nil
+		}
+	case *ast.InterfaceType:
+		return "any", nil
+	}
+	return "", fmt.Errorf("%w %s", errUnsupported, types.ExprString(expr))
+}
+
+// declareTSType generates the TypeScript declaration of the given excelize
+// data type if it has not been declared.
+func (g *generator) declareTSType(name string, decl *typeDecl) error {
+	if _, ok := g.tsTypes[name]; ok || tsDeclared(g.dts, name) {
+		return nil
+	}
+	g.tsTypes[name] = ""
+	var src strings.Builder
+	src.WriteString("  /**\n")
+	src.WriteString(goComment(firstParagraph(decl.doc), "   * "))
+	src.WriteString("   */\n")
+	switch typ := decl.spec.Type.(type) {
+	case *ast.Ident:
+		basic, ok := goBasicTypes[typ.Name]
+		if !ok {
+			return fmt.Errorf("%w %s", errUnsupported, name)
+		}
+		fmt.Fprintf(&src, "  export type %s = %s;\n", name, basic.ts)
+	case *ast.StructType:
+		var fields [][2]string
+		for _, field := range typ.Fields.List {
+			if _, ok := field.Type.(*ast.FuncType); ok {
+				continue
+			}
+			tsType, err := g.tsType(field.Type)
+			if err != nil {
+				return err
+			}
+			for _, ident := range field.Names {
+				if ident.IsExported() {
+					fields = append(fields, [2]string{ident.Name + "?:", tsType})
+				}
+			}
+		}
+		width := 0
+		for _, field := range fields {
+			width = max(width, len(field[0]))
+		}
+		fmt.Fprintf(&src, "  export type %s = {\n", name)
+		for _, field := range fields {
+			fmt.Fprintf(&src, "    %-*s %s;\n", width, field[0], field[1])
+		}
+		src.WriteString("  };\n")
+	default:
+		return fmt.Errorf("%w %s", errUnsupported, name)
+	}
+	g.tsTypes[name] = src.String()
+	return nil
+}
+
+// tsDeclared returns if the given data type has been declared in the
+// TypeScript declarations.
+func tsDeclared(dts, name string) bool {
+	for _, prefix := range []string{"export type ", "export enum ", "export class ", "export interface "} {
+		for _, suffix := range []string{" ", "<"} {
+			if strings.Contains(dts, prefix+name+suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// goSource returns the formatted Go source code of the generated wrapper
+// functions and the registry of all wrapper functions.
+func (g *generator) goSource(bindings []binding, wrappers []string) ([]byte, error) {
+	var src bytes.Buffer
+	src.WriteString(generatedHeader + "\n\npackage main\n\nimport (\n")
+	for _, b := range bindings {
+		if strings.Contains(b.goSrc, "reflect.") {
+			src.WriteString("\"reflect\"\n")
+			break
+		}
+	}
+	src.WriteString("\"syscall/js\"\n\n\"github.com/xuri/excelize/v2\"\n)\n\n")
+	for _, b := range bindings {
+		src.WriteString(b.goSrc + "\n")
+	}
+	src.WriteString("// interopFuncs returns the wrapper functions of the workbook.\n")
+	src.WriteString("func interopFuncs(f *excelize.File) map[string]func(this js.Value, args []js.Value) interface{} {\n")
+	src.WriteString("return map[string]func(this js.Value, args []js.Value) interface{}{\n")
+	for _, name := range wrappers {
+		fmt.Fprintf(&src, "%q: %s(f),\n", name, name)
+	}
+	src.WriteString("}\n}\n")
+	return format.Source(src.Bytes())
+}
+
+// tsSource returns the TypeScript declarations with the updated generated
+// code, the generated data types will be placed before the declaration of the
+// NewFile class, and the methods will be placed at the end of the class.
+func (g *generator) tsSource(bindings []binding) ([]byte, error) {
+	var typesSrc, methodsSrc []string
+	for _, name := range sortedKeys(g.tsTypes) {
+		typesSrc = append(typesSrc, g.tsTypes[name])
+	}
+	for _, b := range bindings {
+		methodsSrc = append(methodsSrc, b.tsSrc)
+	}
+	dts, err := replaceRegion(g.dts, "  ", strings.Join(typesSrc, "\n"))
+	if err != nil {
+		return nil, err
+	}
+	dts, err = replaceRegion(dts, "    ", strings.Join(methodsSrc, "\n"))
+	return []byte(dts), err
+}
+
+// replaceRegion replaces the generated code between the markers with the
+// given indent in the TypeScript declarations.
+func replaceRegion(dts, indent, code string) (string, error) {
+	begin, end := "\n"+indent+dtsBegin+"\n", indent+dtsEnd+"\n"
+	i := strings.Index(dts, begin)
+	j := strings.Index(dts, "\n"+end)
+	if i == -1 || j < i {
+		return dts, fmt.Errorf("missing generated code markers with indent %q in the TypeScript declarations", indent)
+	}
+	if code != "" {
+		code = "\n" + code + "\n"
+	}
+	return dts[:i] + begin + code + dts[j+1:], nil
+}
+
+// firstParagraph returns the first paragraph of the doc comment in a single
+// line, the trailing sentence which introduces the example code will be
+// removed.
+func firstParagraph(doc string) string {
+	paragraph, _, _ := strings.Cut(strings.TrimSpace(doc), "\n\n")
+	paragraph = strings.Join(strings.Fields(paragraph), " ")
+	if strings.HasSuffix(paragraph, ":") {
+		if i := strings.LastIndex(paragraph, ". "); i != -1 {
+			paragraph = paragraph[:i+1]
+		}
+	}
+	return paragraph
+}
+
+// goComment wraps the given text into the comment lines with the given
+// prefix.
+func goComment(text, prefix string) string {
+	var (
+		lines []string
+		line  string
+	)
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(prefix)+len(line)+1+len(word) > lineWidth {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(prefix + line + "\n")
+	}
+	return b.String()
+}
+
+// paramDoc returns the description of the given parameter of the method for
+// the TypeScript declarations, the name parameter will be described by the
+// object of the method, for example: "The pivot table name" for the
+// DeletePivotTable.
+func paramDoc(method, name string) string {
+	if doc, ok := paramDocs[name]; ok {
+		return doc
+	}
+	words := splitWords(name)
+	if name == "name" {
+		words = append(splitWords(method)[1:], name)
+	}
+	return "The " + strings.ToLower(strings.Join(words, " "))
+}
+
+// resultKey returns the field name of the return value in the result of the
+// wrapper function, which is the last word of the method name in lower case,
+// for example: "formats" for the GetConditionalFormats.
+func resultKey(name string) string {
+	words := splitWords(name)
+	return strings.ToLower(words[len(words)-1])
+}
+
+// splitWords splits the camel case name into words.
+func splitWords(name string) []string {
+	var words []string
+	start := 0
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, name[start:i])
+			start = i
+		}
+	}
+	return append(words, name[start:])
+}
+
+// sortedKeys returns the sorted keys of the given map.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
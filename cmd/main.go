@@ -11,12 +11,25 @@
 
 package main
 
+//go:generate go run ./internal/bindgen
+
 import (
+	"archive/zip"
+	"bufio"
 	"bytes"
+	"encoding/binary"
+	"encoding/csv"
 	"errors"
+	"html"
+	"io"
+	"math"
 	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"syscall/js"
+	"time"
 
 	_ "image/gif"
 	_ "image/jpeg"
@@ -25,6 +38,11 @@ import (
 	_ "golang.org/x/image/tiff"
 
 	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
 )
 
 // argsRule represents the rule of the excelize wrapper function argument.
@@ -33,6 +51,15 @@ type argsRule struct {
 	types []js.Type
 }
 
+// jsTypeBigInt defined the type of the JavaScript BigInt value, which is not
+// defined in the syscall/js package.
+const jsTypeBigInt js.Type = -1
+
+// maxSafeInteger defined the maximum safe integer in JavaScript, the integers
+// out of the range of [-maxSafeInteger, maxSafeInteger] can't be represented
+// exactly by the JavaScript number.
+const maxSafeInteger = 1<<53 - 1
+
 var (
 	// goBaseTypes defines Go's basic data types.
 	goBaseTypes = map[reflect.Kind]bool{
@@ -50,56 +77,113 @@ var (
 		reflect.Uintptr: true,
 		reflect.Float32: true,
 		reflect.Float64: true,
-		reflect.Map:     true,
 		reflect.String:  true,
 	}
 	// jsToBaseGoTypeFuncs defined functions mapping for JavaScript to Go basic
 	// data types convention.
 	jsToBaseGoTypeFuncs = map[reflect.Kind]func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error){
 		reflect.Bool: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
-			if jsVal.Type() != js.TypeBoolean {
+			if jsType(jsVal) != js.TypeBoolean {
 				return reflect.ValueOf(nil), errArgType
 			}
 			return reflect.ValueOf(jsVal.Bool()), nil
 		},
 		reflect.Uint: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
-			if jsVal.Type() != js.TypeNumber {
+			if jsType(jsVal) == jsTypeBigInt {
+				v, err := jsBigIntToUint64(jsVal)
+				return reflect.ValueOf(uint(v)), err
+			}
+			if jsType(jsVal) != js.TypeNumber {
 				return reflect.ValueOf(nil), errArgType
 			}
 			return reflect.ValueOf(uint(jsVal.Float())), nil
 		},
 		reflect.Uint8: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
-			if jsVal.Type() != js.TypeNumber {
+			if jsType(jsVal) != js.TypeNumber {
 				return reflect.ValueOf(nil), errArgType
 			}
 			return reflect.ValueOf(uint8(jsVal.Float())), nil
 		},
+		reflect.Uint16: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
+			if jsType(jsVal) != js.TypeNumber {
+				return reflect.ValueOf(nil), errArgType
+			}
+			return reflect.ValueOf(uint16(jsVal.Float())), nil
+		},
+		reflect.Uint32: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
+			if jsType(jsVal) != js.TypeNumber {
+				return reflect.ValueOf(nil), errArgType
+			}
+			return reflect.ValueOf(uint32(jsVal.Float())), nil
+		},
 		reflect.Uint64: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
-			if jsVal.Type() != js.TypeNumber {
+			if jsType(jsVal) == jsTypeBigInt {
+				v, err := jsBigIntToUint64(jsVal)
+				return reflect.ValueOf(uint64(v)), err
+			}
+			if jsType(jsVal) != js.TypeNumber {
 				return reflect.ValueOf(nil), errArgType
 			}
 			return reflect.ValueOf(uint64(jsVal.Float())), nil
 		},
+		reflect.Uintptr: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
+			if jsType(jsVal) != js.TypeNumber {
+				return reflect.ValueOf(nil), errArgType
+			}
+			return reflect.ValueOf(uintptr(jsVal.Float())), nil
+		},
 		reflect.Int: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
-			if jsVal.Type() != js.TypeNumber {
+			if jsType(jsVal) == jsTypeBigInt {
+				v, err := jsBigIntToInt64(jsVal)
+				return reflect.ValueOf(int(v)), err
+			}
+			if jsType(jsVal) != js.TypeNumber {
 				return reflect.ValueOf(nil), errArgType
 			}
 			return reflect.ValueOf(int(jsVal.Float())), nil
 		},
+		reflect.Int8: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
+			if jsType(jsVal) != js.TypeNumber {
+				return reflect.ValueOf(nil), errArgType
+			}
+			return reflect.ValueOf(int8(jsVal.Float())), nil
+		},
+		reflect.Int16: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
+			if jsType(jsVal) != js.TypeNumber {
+				return reflect.ValueOf(nil), errArgType
+			}
+			return reflect.ValueOf(int16(jsVal.Float())), nil
+		},
+		reflect.Int32: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
+			if jsType(jsVal) != js.TypeNumber {
+				return reflect.ValueOf(nil), errArgType
+			}
+			return reflect.ValueOf(int32(jsVal.Float())), nil
+		},
 		reflect.Int64: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
-			if jsVal.Type() != js.TypeNumber {
+			if jsType(jsVal) == jsTypeBigInt {
+				v, err := jsBigIntToInt64(jsVal)
+				return reflect.ValueOf(int64(v)), err
+			}
+			if jsType(jsVal) != js.TypeNumber {
 				return reflect.ValueOf(nil), errArgType
 			}
 			return reflect.ValueOf(int64(jsVal.Float())), nil
 		},
+		reflect.Float32: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
+			if jsType(jsVal) != js.TypeNumber {
+				return reflect.ValueOf(nil), errArgType
+			}
+			return reflect.ValueOf(float32(jsVal.Float())), nil
+		},
 		reflect.Float64: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
-			if jsVal.Type() != js.TypeNumber {
+			if jsType(jsVal) != js.TypeNumber {
 				return reflect.ValueOf(nil), errArgType
 			}
 			return reflect.ValueOf(jsVal.Float()), nil
 		},
 		reflect.String: func(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
-			if jsVal.Type() != js.TypeString {
+			if jsType(jsVal) != js.TypeString {
 				return reflect.ValueOf(nil), errArgType
 			}
 			return reflect.ValueOf(jsVal.String()), nil
@@ -126,24 +210,66 @@ var (
 			}
 			return uint8(goVal.Uint()), nil
 		},
+		reflect.Uint16: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
+			if kind != goVal.Kind() {
+				return nil, errArgType
+			}
+			return int(goVal.Uint()), nil
+		},
+		reflect.Uint32: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
+			if kind != goVal.Kind() {
+				return nil, errArgType
+			}
+			return int(goVal.Uint()), nil
+		},
 		reflect.Uint64: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
 			if kind != goVal.Kind() {
 				return nil, errArgType
 			}
 			return int(goVal.Uint()), nil
 		},
+		reflect.Uintptr: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
+			if kind != goVal.Kind() {
+				return nil, errArgType
+			}
+			return int(goVal.Uint()), nil
+		},
 		reflect.Int: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
 			if kind != goVal.Kind() {
 				return nil, errArgType
 			}
 			return int(goVal.Int()), nil
 		},
+		reflect.Int8: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
+			if kind != goVal.Kind() {
+				return nil, errArgType
+			}
+			return int(goVal.Int()), nil
+		},
+		reflect.Int16: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
+			if kind != goVal.Kind() {
+				return nil, errArgType
+			}
+			return int(goVal.Int()), nil
+		},
+		reflect.Int32: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
+			if kind != goVal.Kind() {
+				return nil, errArgType
+			}
+			return int(goVal.Int()), nil
+		},
 		reflect.Int64: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
 			if kind != goVal.Kind() {
 				return nil, errArgType
 			}
 			return goVal.Int(), nil
 		},
+		reflect.Float32: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
+			if kind != goVal.Kind() {
+				return nil, errArgType
+			}
+			return goVal.Float(), nil
+		},
 		reflect.Float64: func(goVal reflect.Value, kind reflect.Kind) (interface{}, error) {
 			if kind != goVal.Kind() {
 				return nil, errArgType
@@ -159,16 +285,451 @@ var (
 	}
 	errArgNum  = errors.New("invalid arguments in call")
 	errArgType = errors.New("invalid argument data type")
+	// errFileClosed defined the error message on calling the functions of the
+	// closed workbook.
+	errFileClosed = errors.New("file closed")
+	// errObjectClosed defined the error message on calling the functions of
+	// the finished iterator or the flushed stream writer.
+	errObjectClosed = errors.New("object closed")
+	// errWorkbookBusy defined the error message on calling the functions of
+	// the workbook which is being written to the stream asynchronously.
+	errWorkbookBusy = errors.New("workbook is being written")
+	// errArgField defined the error message on the unknown option field in
+	// the strict mode.
+	errArgField = errors.New("unknown option field")
+	// errArgValue defined the error message on the invalid enumeration value
+	// or out of range number of the option field in the strict mode.
+	errArgValue = errors.New("invalid argument value")
+	// errCancelled defined the error message on the function call cancelled by
+	// the AbortSignal of the Signal option.
+	errCancelled = errors.New("operation cancelled")
+	// strictMode defined the global option validation mode, the unknown
+	// option fields, invalid enumeration values and out of range numbers will
+	// be reported as errors before calling the excelize in the strict mode.
+	strictMode bool
+	// optionEnums defined the enumeration values of the string option fields
+	// by the Go structure type and field name.
+	optionEnums = map[reflect.Type]map[string][]string{
+		reflect.TypeFor[excelize.Alignment](): {
+			"Horizontal": {"general", "left", "center", "right", "fill", "justify", "centerContinuous", "distributed"},
+			"Vertical":   {"top", "center", "bottom", "justify", "distributed"},
+		},
+		reflect.TypeFor[excelize.Border](): {
+			"Type": {"left", "right", "top", "bottom", "diagonalDown", "diagonalUp"},
+		},
+		reflect.TypeFor[excelize.CalcPropsOptions](): {
+			"CalcMode": {"manual", "auto", "autoNoTable"},
+			"RefMode":  {"A1", "R1C1"},
+		},
+		reflect.TypeFor[excelize.Chart](): {
+			"ShowBlanksAs": {"gap", "span", "zero"},
+		},
+		reflect.TypeFor[excelize.ChartLegend](): {
+			"Position": {"none", "bottom", "left", "right", "top", "top_right"},
+		},
+		reflect.TypeFor[excelize.ChartMarker](): {
+			"Symbol": {"circle", "dash", "diamond", "dot", "none", "picture", "plus", "square", "star", "triangle", "x", "auto"},
+		},
+		reflect.TypeFor[excelize.ConditionalFormatOptions](): {
+			"Type": {"cell", "average", "duplicate", "unique", "top", "bottom", "text", "time_period", "blanks", "no_blanks",
+				"errors", "no_errors", "2_color_scale", "3_color_scale", "data_bar", "formula", "icon_set"},
+		},
+		reflect.TypeFor[excelize.Fill](): {
+			"Type": {"gradient", "pattern"},
+		},
+		reflect.TypeFor[excelize.Font](): {
+			"VertAlign": {"baseline", "superscript", "subscript"},
+		},
+		reflect.TypeFor[excelize.GraphicOptions](): {
+			"Positioning": {"absolute", "oneCell", "twoCell"},
+		},
+		reflect.TypeFor[excelize.PageLayoutOptions](): {
+			"Orientation": {"portrait", "landscape"},
+			"PageOrder":   {"overThenDown", "downThenOver"},
+		},
+		reflect.TypeFor[excelize.Panes](): {
+			"ActivePane": {"bottomLeft", "bottomRight", "topLeft", "topRight"},
+		},
+		reflect.TypeFor[excelize.PivotTableField](): {
+			"Subtotal": {"average", "count", "countNums", "max", "min", "product", "stdDev", "stdDevp", "sum", "var", "varp"},
+		},
+		reflect.TypeFor[excelize.Selection](): {
+			"Pane": {"bottomLeft", "bottomRight", "topLeft", "topRight"},
+		},
+		reflect.TypeFor[excelize.SparklineOptions](): {
+			"Type": {"line", "column", "win_loss"},
+		},
+		reflect.TypeFor[excelize.ViewOptions](): {
+			"View": {"normal", "pageLayout", "pageBreakPreview"},
+		},
+		reflect.TypeFor[csvOptions](): {
+			"Quote":      {"minimal", "all", "nonNumeric", "none"},
+			"LineEnding": {"\r\n", "\n"},
+			"HiddenRows": {"include", "skip"},
+			"HiddenCols": {"include", "skip"},
+		},
+	}
+	// optionEnumTypes defined the maximum value of the numeric enumeration
+	// data types.
+	optionEnumTypes = map[reflect.Type]uint64{
+		reflect.TypeOf(excelize.ChartDataLabelPositionType(0)): uint64(excelize.ChartDataLabelsPositionAbove),
+		reflect.TypeOf(excelize.ChartTickLabelPositionType(0)): uint64(excelize.ChartTickLabelNone),
+		reflect.TypeOf(excelize.ChartType(0)):                  uint64(excelize.StockOpenHighLowClose),
+		reflect.TypeOf(excelize.CultureName(0)):                uint64(excelize.CultureNameZhTW),
+		reflect.TypeOf(excelize.FormControlType(0)):            uint64(excelize.FormControlScrollBar),
+		reflect.TypeOf(excelize.LineDashType(0)):               uint64(excelize.LineDashSysDashDotDot),
+		reflect.TypeOf(excelize.LineType(0)):                   uint64(excelize.LineAutomatic),
+		reflect.TypeOf(excelize.PivotTableShowValuesAsType(0)): uint64(excelize.PivotTableShowValuesAsIndex),
+	}
+	// throwOnError defined the global error handling mode, the functions will
+	// throw the ExcelizeError instead of returning the result object with the
+	// error field in the exception mode.
+	throwOnError bool
+	// excelizeError defined the ExcelizeError class, a subclass of the
+	// JavaScript Error, which carries the error code, argument index and path
+	// of the excelize error.
+	excelizeError = newErrorClass()
+	// throwIfErrorFunc defined the JavaScript helper function for creating the
+	// wrapper function which throws the returned ExcelizeError.
+	throwIfErrorFunc js.Value
+	// nodeEventFunc defined the JavaScript helper function for creating the
+	// promise which will be fulfilled on the given event of the Node.js
+	// stream, or be rejected on the error event.
+	nodeEventFunc js.Value
+	// noopFunc defined the JavaScript helper function which does nothing.
+	noopFunc js.Value
+	// globalFuncs defined the exported JavaScript functions on the Window or
+	// Global.
+	globalFuncs = map[string]js.Func{}
+	// fileStates defined the states of the opened workbooks.
+	fileStates = map[*excelize.File]*fileState{}
+	// fileClosedFunc defined the stub function of the closed workbook, which
+	// returns the file closed error.
+	fileClosedFunc = js.FuncOf(fileClosed)
+	// fileClosedThrowFunc defined the stub function of the closed workbook in
+	// the exception mode, which throws the file closed error.
+	fileClosedThrowFunc js.Value
+	// fileClosedPromiseFunc defined the stub function of the closed workbook,
+	// which returns a promise rejected with the file closed error.
+	fileClosedPromiseFunc = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		jsErr := js.Global().Get("Error").New(errFileClosed.Error())
+		jsErr.Set("code", errorCode(errFileClosed))
+		return js.Global().Get("Promise").Call("reject", jsErr)
+	})
+	// objectClosedFunc defined the stub function of the finished iterator or
+	// the flushed stream writer, which returns the object closed error.
+	objectClosedFunc = js.FuncOf(objectClosed)
+	// objectClosedThrowFunc defined the stub function of the finished
+	// iterator or the flushed stream writer in the exception mode, which
+	// throws the object closed error.
+	objectClosedThrowFunc js.Value
+	// resultStubFunc defined the JavaScript helper function for creating the
+	// stub function which returns the fixed result.
+	resultStubFunc js.Value
+	// iteratorDonePromiseFunc defined the stub function of the finished async
+	// iterator, which returns a promise resolved with the done result.
+	iteratorDonePromiseFunc = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		return js.Global().Get("Promise").Call("resolve", js.ValueOf(map[string]interface{}{
+			"value": js.Undefined(), "done": true,
+		}))
+	})
+	// iteratorDoneFunc defined the stub function of the async iterator of the
+	// finished iterator, which returns an iterator that yields nothing.
+	iteratorDoneFunc = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		return js.ValueOf(map[string]interface{}{
+			"next": iteratorDonePromiseFunc, "return": iteratorDonePromiseFunc,
+		})
+	})
+	// fileClosedIteratorFunc defined the stub function of the async iterator
+	// of the closed workbook, which returns an iterator that rejects with the
+	// file closed error.
+	fileClosedIteratorFunc = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		return js.ValueOf(map[string]interface{}{
+			"next": fileClosedPromiseFunc, "return": fileClosedPromiseFunc,
+		})
+	})
+	// errorCodes defined the stable error codes of the sentinel errors, which
+	// could be used to identify the error without matching the error message.
+	errorCodes = []struct {
+		err  error
+		code string
+	}{
+		{errArgNum, "ERR_ARG_NUM"},
+		{errArgType, "ERR_ARG_TYPE"},
+		{errFileClosed, "ERR_FILE_CLOSED"},
+		{errObjectClosed, "ERR_OBJECT_CLOSED"},
+		{errWorkbookBusy, "ERR_WORKBOOK_BUSY"},
+		{errArgField, "ERR_ARG_FIELD"},
+		{errArgValue, "ERR_ARG_VALUE"},
+		{errCancelled, "ERR_CANCELLED"},
+		{excelize.ErrAddVBAProject, "ERR_ADD_VBA_PROJECT"},
+		{excelize.ErrAttrValBool, "ERR_ATTR_VAL_BOOL"},
+		{excelize.ErrCellCharsLength, "ERR_CELL_CHARS_LENGTH"},
+		{excelize.ErrCellStyles, "ERR_CELL_STYLES"},
+		{excelize.ErrChartTitle, "ERR_CHART_TITLE"},
+		{excelize.ErrColumnNumber, "ERR_COLUMN_NUMBER"},
+		{excelize.ErrColumnWidth, "ERR_COLUMN_WIDTH"},
+		{excelize.ErrCoordinates, "ERR_COORDINATES"},
+		{excelize.ErrCustomNumFmt, "ERR_CUSTOM_NUM_FMT"},
+		{excelize.ErrDataValidationFormulaLength, "ERR_DATA_VALIDATION_FORMULA_LENGTH"},
+		{excelize.ErrDataValidationRange, "ERR_DATA_VALIDATION_RANGE"},
+		{excelize.ErrDefinedNameDuplicate, "ERR_DEFINED_NAME_DUPLICATE"},
+		{excelize.ErrDefinedNameScope, "ERR_DEFINED_NAME_SCOPE"},
+		{excelize.ErrExistsSheet, "ERR_EXISTS_SHEET"},
+		{excelize.ErrExistsTableName, "ERR_EXISTS_TABLE_NAME"},
+		{excelize.ErrFillType, "ERR_FILL_TYPE"},
+		{excelize.ErrFillGradientColor, "ERR_FILL_GRADIENT_COLOR"},
+		{excelize.ErrFillGradientShading, "ERR_FILL_GRADIENT_SHADING"},
+		{excelize.ErrFillPatternColor, "ERR_FILL_PATTERN_COLOR"},
+		{excelize.ErrFillPattern, "ERR_FILL_PATTERN"},
+		{excelize.ErrFontLength, "ERR_FONT_LENGTH"},
+		{excelize.ErrFontSize, "ERR_FONT_SIZE"},
+		{excelize.ErrFormControlValue, "ERR_FORM_CONTROL_VALUE"},
+		{excelize.ErrGroupSheets, "ERR_GROUP_SHEETS"},
+		{excelize.ErrImgExt, "ERR_IMG_EXT"},
+		{excelize.ErrInvalidFormula, "ERR_INVALID_FORMULA"},
+		{excelize.ErrMaxFilePathLength, "ERR_MAX_FILE_PATH_LENGTH"},
+		{excelize.ErrMaxRowHeight, "ERR_MAX_ROW_HEIGHT"},
+		{excelize.ErrMaxRows, "ERR_MAX_ROWS"},
+		{excelize.ErrNameLength, "ERR_NAME_LENGTH"},
+		{excelize.ErrMaxGraphicAltTextLength, "ERR_MAX_GRAPHIC_ALT_TEXT_LENGTH"},
+		{excelize.ErrMaxGraphicNameLength, "ERR_MAX_GRAPHIC_NAME_LENGTH"},
+		{excelize.ErrOptionsUnzipSizeLimit, "ERR_OPTIONS_UNZIP_SIZE_LIMIT"},
+		{excelize.ErrOutlineLevel, "ERR_OUTLINE_LEVEL"},
+		{excelize.ErrPageSetupAdjustTo, "ERR_PAGE_SETUP_ADJUST_TO"},
+		{excelize.ErrParameterInvalid, "ERR_PARAMETER_INVALID"},
+		{excelize.ErrParameterRequired, "ERR_PARAMETER_REQUIRED"},
+		{excelize.ErrPasswordLengthInvalid, "ERR_PASSWORD_LENGTH_INVALID"},
+		{excelize.ErrPivotTableShowValuesAsBaseField, "ERR_PIVOT_TABLE_SHOW_VALUES_AS_BASE_FIELD"},
+		{excelize.ErrPivotTableShowValuesAsBaseItem, "ERR_PIVOT_TABLE_SHOW_VALUES_AS_BASE_ITEM"},
+		{excelize.ErrPivotTableClassicLayout, "ERR_PIVOT_TABLE_CLASSIC_LAYOUT"},
+		{excelize.ErrSave, "ERR_SAVE"},
+		{excelize.ErrSheetIdx, "ERR_SHEET_IDX"},
+		{excelize.ErrSheetNameBlank, "ERR_SHEET_NAME_BLANK"},
+		{excelize.ErrSheetNameInvalid, "ERR_SHEET_NAME_INVALID"},
+		{excelize.ErrSheetNameLength, "ERR_SHEET_NAME_LENGTH"},
+		{excelize.ErrSheetNameSingleQuote, "ERR_SHEET_NAME_SINGLE_QUOTE"},
+		{excelize.ErrSparkline, "ERR_SPARKLINE"},
+		{excelize.ErrSparklineLocation, "ERR_SPARKLINE_LOCATION"},
+		{excelize.ErrSparklineRange, "ERR_SPARKLINE_RANGE"},
+		{excelize.ErrSparklineStyle, "ERR_SPARKLINE_STYLE"},
+		{excelize.ErrSparklineType, "ERR_SPARKLINE_TYPE"},
+		{excelize.ErrTotalSheetHyperlinks, "ERR_TOTAL_SHEET_HYPERLINKS"},
+		{excelize.ErrTransparency, "ERR_TRANSPARENCY"},
+		{excelize.ErrUnknownEncryptMechanism, "ERR_UNKNOWN_ENCRYPT_MECHANISM"},
+		{excelize.ErrUnprotectSheet, "ERR_UNPROTECT_SHEET"},
+		{excelize.ErrUnprotectSheetPassword, "ERR_UNPROTECT_SHEET_PASSWORD"},
+		{excelize.ErrUnprotectWorkbook, "ERR_UNPROTECT_WORKBOOK"},
+		{excelize.ErrUnprotectWorkbookPassword, "ERR_UNPROTECT_WORKBOOK_PASSWORD"},
+		{excelize.ErrUnsupportedEncryptMechanism, "ERR_UNSUPPORTED_ENCRYPT_MECHANISM"},
+		{excelize.ErrUnsupportedHashAlgorithm, "ERR_UNSUPPORTED_HASH_ALGORITHM"},
+		{excelize.ErrUnsupportedNumberFormat, "ERR_UNSUPPORTED_NUMBER_FORMAT"},
+		{excelize.ErrUnsupportedPivotTableShowValuesAsType, "ERR_UNSUPPORTED_PIVOT_TABLE_SHOW_VALUES_AS_TYPE"},
+		{excelize.ErrWorkbookFileFormat, "ERR_WORKBOOK_FILE_FORMAT"},
+		{excelize.ErrWorkbookPassword, "ERR_WORKBOOK_PASSWORD"},
+	}
+	// unboundFuncs defined the methods of the excelize.File which don't have
+	// the binding and the reason, the bindings of the other methods will be
+	// generated by the bindgen tool if they don't have the hand-written
+	// wrapper functions.
+	unboundFuncs = map[string]string{
+		"AddPicture":         "the file system is not available, use AddPictureFromBytes instead",
+		"ReadZipReader":      "the zip reader typed argument is not supported",
+		"Save":               "the file system is not available, use WriteToBuffer instead",
+		"SaveAs":             "the file system is not available, use WriteToBuffer instead",
+		"SetSheetBackground": "the file system is not available, use SetSheetBackgroundFromBytes instead",
+		"SetZipWriter":       "the function typed argument is not supported",
+		"Write":              "the io.Writer typed argument is not supported, use WriteTo or WriteToBuffer instead",
+	}
 )
 
+// fileState represents the state of an opened workbook or an object derived
+// from it, such as stream writer and iterators, which tracks the JavaScript
+// functions registered for the workbook or the object. The functions of the
+// derived object will be released after the object finished, such as the
+// iterator closed or the stream writer flushed, or the workbook closed.
+type fileState struct {
+	funcs        []js.Func
+	props        []funcProp
+	handles      map[*fileState]struct{}
+	parent       *fileState
+	workbook     js.Value
+	throwOnError bool
+	strict       bool
+	bigInt       bool
+	writing      bool
+}
+
+// funcProp represents a function field of the JavaScript object, which will
+// be replaced with the stub function after the workbook closed, or the done
+// stub function after the derived object finished.
+type funcProp struct {
+	obj, key, stub, done js.Value
+}
+
+// handle returns the state of a new object derived from the workbook, with
+// the error handling mode and option validation mode of the workbook.
+func (st *fileState) handle() *fileState {
+	h := &fileState{parent: st, workbook: st.workbook, throwOnError: st.throwOnError, strict: st.strict, bigInt: st.bigInt}
+	if st.handles == nil {
+		st.handles = map[*fileState]struct{}{}
+	}
+	st.handles[h] = struct{}{}
+	return h
+}
+
+// finish releases the JavaScript functions registered for the finished
+// derived object, and replaces the function fields of the object with the
+// given stub functions, the other fields will be replaced with their done
+// stub functions.
+func (st *fileState) finish(stubs map[string]js.Value) {
+	if _, ok := st.parent.handles[st]; !ok {
+		return
+	}
+	delete(st.parent.handles, st)
+	st.release(true, stubs)
+}
+
+// release releases the JavaScript functions registered for the workbook or
+// the derived object, and replaces the function fields with the stub
+// functions of the closed workbook, or the given stub functions and done stub
+// functions if the derived object finished.
+func (st *fileState) release(finished bool, stubs map[string]js.Value) {
+	for _, prop := range st.props {
+		stub := prop.stub
+		if finished {
+			stub = prop.done
+			if jsType(prop.key) == js.TypeString {
+				if value, ok := stubs[prop.key.String()]; ok {
+					stub = value
+				}
+			}
+		}
+		js.Global().Get("Reflect").Call("set", prop.obj, prop.key, stub)
+	}
+	for _, fn := range st.funcs {
+		fn.Release()
+	}
+	st.funcs, st.props = nil, nil
+}
+
+// busy checks if the workbook is being written to the stream asynchronously,
+// the functions of the workbook and the derived objects can't be called until
+// the writing finished.
+func (st *fileState) busy() bool {
+	if st.parent != nil {
+		return st.parent.writing
+	}
+	return st.writing
+}
+
+// funcOf returns a JavaScript function by given Go function, and tracks it
+// for releasing after the workbook closed.
+func (st *fileState) funcOf(fn func(this js.Value, args []js.Value) interface{}) js.Func {
+	f := js.FuncOf(fn)
+	st.funcs = append(st.funcs, f)
+	return f
+}
+
+// export returns a JavaScript function by given Go function with the error
+// handling mode of the workbook, and tracks it for releasing after the
+// workbook closed. The returned function returns the workbook busy error
+// while the workbook is being written to the stream asynchronously.
+func (st *fileState) export(impl func(this js.Value, args []js.Value) interface{}) js.Value {
+	fn := func(this js.Value, args []js.Value) interface{} {
+		if st.busy() {
+			return workbookBusy(this, args)
+		}
+		return impl(this, args)
+	}
+	if !st.throwOnError {
+		return st.funcOf(fn).Value
+	}
+	return throwIfError(st.funcOf(throwable(fn)).Value)
+}
+
+// resultStub returns the JavaScript function which returns a copy of the
+// result of the given function called without arguments, or throws the result
+// if it's an ExcelizeError, for the fixed values such as the error of the
+// finished iterator. The returned function doesn't hold any Go function, and
+// returns the file closed error after the workbook closed.
+func (st *fileState) resultStub(fn func(this js.Value, args []js.Value) interface{}) js.Value {
+	if st.throwOnError {
+		fn = throwable(fn)
+	}
+	return resultStubFunc.Invoke(fn(js.Undefined(), []js.Value{}), st.workbook, st.closedStub(), excelizeError)
+}
+
+// closedStub returns the stub function of the closed workbook with the error
+// handling mode of the workbook.
+func (st *fileState) closedStub() js.Value {
+	if !st.throwOnError {
+		return fileClosedFunc.Value
+	}
+	if fileClosedThrowFunc.IsUndefined() {
+		fileClosedThrowFunc = throwIfError(js.FuncOf(throwable(fileClosed)).Value)
+	}
+	return fileClosedThrowFunc
+}
+
+// objectClosedStub returns the stub function of the finished iterator or the
+// flushed stream writer with the error handling mode of the workbook.
+func (st *fileState) objectClosedStub() js.Value {
+	if !st.throwOnError {
+		return objectClosedFunc.Value
+	}
+	if objectClosedThrowFunc.IsUndefined() {
+		objectClosedThrowFunc = throwIfError(js.FuncOf(throwable(objectClosed)).Value)
+	}
+	return objectClosedThrowFunc
+}
+
+// argError represents an error caused by the argument of the wrapper
+// function, with the index of the argument and the path of the option field
+// in the argument, for example: Series[2].Marker.Symbol.
+type argError struct {
+	err   error
+	index int
+	path  string
+	value js.Value
+}
+
+// Error returns the error message of the underlying error.
+func (e *argError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *argError) Unwrap() error {
+	return e.err
+}
+
 func main() {
 	c := make(chan struct{})
 	regFuncs()
 	<-c
 }
 
+// regHelpers loads the JavaScript helper functions installed on the excelize
+// object by the src/index.js before running, and removes them from the
+// object. These functions are defined statically instead of created by the
+// Function constructor, to work with the Content-Security-Policy without the
+// 'unsafe-eval'.
+func regHelpers() {
+	helpers := js.Global().Get("excelize").Get("helpers")
+	if jsType(helpers) != js.TypeObject {
+		return
+	}
+	js.Global().Get("excelize").Delete("helpers")
+	resultStubFunc = helpers.Get("resultStub")
+	throwIfErrorFunc = helpers.Get("throwIfError")
+	nodeEventFunc = helpers.Get("nodeEvent")
+	noopFunc = helpers.Get("noop")
+}
+
 // regFuncs register all exported JavaScript functions on the Window ot Global.
 func regFuncs() {
+	regHelpers()
 	for name, impl := range map[string]func(this js.Value, args []js.Value) interface{}{
 		"CellNameToCoordinates": CellNameToCoordinates,
 		"ColumnNameToNumber":    ColumnNameToNumber,
@@ -181,12 +742,34 @@ func regFuncs() {
 		"ThemeColor":            ThemeColor,
 		"NewFile":               NewFile,
 		"OpenReader":            OpenReader,
+		"SetStrictMode":         SetStrictMode,
+		"SetThrowOnError":       SetThrowOnError,
 	} {
-		js.Global().Get("excelize").Set(name, js.FuncOf(impl))
+		impl := impl
+		globalFuncs[name] = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			if throwOnError {
+				return throwable(impl)(this, args)
+			}
+			return impl(this, args)
+		})
 	}
+	exportGlobalFuncs()
+	js.Global().Get("excelize").Set("ExcelizeError", excelizeError)
 	regConstants()
 }
 
+// exportGlobalFuncs set the exported JavaScript functions on the Window or
+// Global with the global error handling mode.
+func exportGlobalFuncs() {
+	for name, fn := range globalFuncs {
+		if throwOnError {
+			js.Global().Get("excelize").Set(name, throwIfError(fn.Value))
+			continue
+		}
+		js.Global().Get("excelize").Set(name, fn)
+	}
+}
+
 // regConstants register all exported JavaScript functions on the Window ot Global.
 func regConstants() {
 	for name, constant := range map[string]int{
@@ -347,169 +930,235 @@ func regConstants() {
 }
 
 // regInteropFunc register all exported JavaScript functions.
-func regInteropFunc(f *excelize.File, fn map[string]interface{}) interface{} {
-	for name, impl := range map[string]func(this js.Value, args []js.Value) interface{}{
-		"AddChart":                    AddChart(f),
-		"AddChartSheet":               AddChartSheet(f),
-		"AddComment":                  AddComment(f),
-		"AddDataValidation":           AddDataValidation(f),
-		"AddFormControl":              AddFormControl(f),
-		"AddHeaderFooterImage":        AddHeaderFooterImage(f),
-		"AddIgnoredErrors":            AddIgnoredErrors(f),
-		"AddPictureFromBytes":         AddPictureFromBytes(f),
-		"AddPivotTable":               AddPivotTable(f),
-		"AddShape":                    AddShape(f),
-		"AddSlicer":                   AddSlicer(f),
-		"AddSparkline":                AddSparkline(f),
-		"AddTable":                    AddTable(f),
-		"AddVBAProject":               AddVBAProject(f),
-		"AutoFilter":                  AutoFilter(f),
-		"CalcCellValue":               CalcCellValue(f),
-		"CopySheet":                   CopySheet(f),
-		"DeleteChart":                 DeleteChart(f),
-		"DeleteComment":               DeleteComment(f),
-		"DeleteDataValidation":        DeleteDataValidation(f),
-		"DeleteDefinedName":           DeleteDefinedName(f),
-		"DeleteFormControl":           DeleteFormControl(f),
-		"DeletePicture":               DeletePicture(f),
-		"DeleteSheet":                 DeleteSheet(f),
-		"DeleteSlicer":                DeleteSlicer(f),
-		"DeleteTable":                 DeleteTable(f),
-		"DuplicateRow":                DuplicateRow(f),
-		"DuplicateRowTo":              DuplicateRowTo(f),
-		"GetActiveSheetIndex":         GetActiveSheetIndex(f),
-		"GetAppProps":                 GetAppProps(f),
-		"GetBaseColor":                GetBaseColor(f),
-		"GetCalcProps":                GetCalcProps(f),
-		"GetCellFormula":              GetCellFormula(f),
-		"GetCellHyperLink":            GetCellHyperLink(f),
-		"GetCellRichText":             GetCellRichText(f),
-		"GetCellStyle":                GetCellStyle(f),
-		"GetCellType":                 GetCellType(f),
-		"GetCellValue":                GetCellValue(f),
-		"GetColOutlineLevel":          GetColOutlineLevel(f),
-		"GetCols":                     GetCols(f),
-		"GetColStyle":                 GetColStyle(f),
-		"GetColVisible":               GetColVisible(f),
-		"GetColWidth":                 GetColWidth(f),
-		"GetComments":                 GetComments(f),
-		"GetConditionalStyle":         GetConditionalStyle(f),
-		"GetCustomProps":              GetCustomProps(f),
-		"GetDataValidations":          GetDataValidations(f),
-		"GetDefaultFont":              GetDefaultFont(f),
-		"GetDefinedName":              GetDefinedName(f),
-		"GetDocProps":                 GetDocProps(f),
-		"GetFormControls":             GetFormControls(f),
-		"GetHyperLinkCells":           GetHyperLinkCells(f),
-		"GetHeaderFooter":             GetHeaderFooter(f),
-		"GetMergeCells":               GetMergeCells(f),
-		"GetPageLayout":               GetPageLayout(f),
-		"GetPageMargins":              GetPageMargins(f),
-		"GetPanes":                    GetPanes(f),
-		"GetPictures":                 GetPictures(f),
-		"GetPictureCells":             GetPictureCells(f),
-		"GetPivotTables":              GetPivotTables(f),
-		"GetRowHeight":                GetRowHeight(f),
-		"GetRowOutlineLevel":          GetRowOutlineLevel(f),
-		"GetRows":                     GetRows(f),
-		"GetRowVisible":               GetRowVisible(f),
-		"GetSheetDimension":           GetSheetDimension(f),
-		"GetSheetIndex":               GetSheetIndex(f),
-		"GetSheetList":                GetSheetList(f),
-		"GetSheetMap":                 GetSheetMap(f),
-		"GetSheetName":                GetSheetName(f),
-		"GetSheetProps":               GetSheetProps(f),
-		"GetSheetProtection":          GetSheetProtection(f),
-		"GetSheetView":                GetSheetView(f),
-		"GetSheetVisible":             GetSheetVisible(f),
-		"GetSlicers":                  GetSlicers(f),
-		"GetStyle":                    GetStyle(f),
-		"GetTables":                   GetTables(f),
-		"GetWorkbookProps":            GetWorkbookProps(f),
-		"GroupSheets":                 GroupSheets(f),
-		"InsertCols":                  InsertCols(f),
-		"InsertPageBreak":             InsertPageBreak(f),
-		"InsertRows":                  InsertRows(f),
-		"MergeCell":                   MergeCell(f),
-		"MoveSheet":                   MoveSheet(f),
-		"NewConditionalStyle":         NewConditionalStyle(f),
-		"NewSheet":                    NewSheet(f),
-		"NewStyle":                    NewStyle(f),
-		"ProtectSheet":                ProtectSheet(f),
-		"ProtectWorkbook":             ProtectWorkbook(f),
-		"RemoveCol":                   RemoveCol(f),
-		"RemovePageBreak":             RemovePageBreak(f),
-		"RemoveRow":                   RemoveRow(f),
-		"SearchSheet":                 SearchSheet(f),
-		"SetActiveSheet":              SetActiveSheet(f),
-		"SetAppProps":                 SetAppProps(f),
-		"SetCalcProps":                SetCalcProps(f),
-		"SetCellBool":                 SetCellBool(f),
-		"SetCellDefault":              SetCellDefault(f),
-		"SetCellFloat":                SetCellFloat(f),
-		"SetCellFormula":              SetCellFormula(f),
-		"SetCellHyperLink":            SetCellHyperLink(f),
-		"SetCellInt":                  SetCellInt(f),
-		"SetCellRichText":             SetCellRichText(f),
-		"SetCellStr":                  SetCellStr(f),
-		"SetCellStyle":                SetCellStyle(f),
-		"SetCellUint":                 SetCellInt(f),
-		"SetCellValue":                SetCellValue(f),
-		"SetColOutlineLevel":          SetColOutlineLevel(f),
-		"SetColStyle":                 SetColStyle(f),
-		"SetColVisible":               SetColVisible(f),
-		"SetColWidth":                 SetColWidth(f),
-		"SetConditionalFormat":        SetConditionalFormat(f),
-		"SetCustomProps":              SetCustomProps(f),
-		"SetDefaultFont":              SetDefaultFont(f),
-		"SetDefinedName":              SetDefinedName(f),
-		"SetDocProps":                 SetDocProps(f),
-		"SetHeaderFooter":             SetHeaderFooter(f),
-		"SetPageLayout":               SetPageLayout(f),
-		"SetPageMargins":              SetPageMargins(f),
-		"SetPanes":                    SetPanes(f),
-		"SetRowHeight":                SetRowHeight(f),
-		"SetRowOutlineLevel":          SetRowOutlineLevel(f),
-		"SetRowStyle":                 SetRowStyle(f),
-		"SetRowVisible":               SetRowVisible(f),
-		"SetSheetBackgroundFromBytes": SetSheetBackgroundFromBytes(f),
-		"SetSheetCol":                 SetSheetCol(f),
-		"SetSheetDimension":           SetSheetDimension(f),
-		"SetSheetName":                SetSheetName(f),
-		"SetSheetProps":               SetSheetProps(f),
-		"SetSheetRow":                 SetSheetRow(f),
-		"SetSheetView":                SetSheetView(f),
-		"SetSheetVisible":             SetSheetVisible(f),
-		"SetWorkbookProps":            SetWorkbookProps(f),
-		"UngroupSheets":               UngroupSheets(f),
-		"UnmergeCell":                 UnmergeCell(f),
-		"UnprotectSheet":              UnprotectSheet(f),
-		"UnprotectWorkbook":           UnprotectWorkbook(f),
-		"UnsetConditionalFormat":      UnsetConditionalFormat(f),
-		"UpdateLinkedValue":           UpdateLinkedValue(f),
-		"WriteToBuffer":               WriteToBuffer(f),
+func regInteropFunc(f *excelize.File, fn map[string]interface{}, throw, strict, bigInt bool) interface{} {
+	fileStates[f] = &fileState{
+		workbook:     js.ValueOf(map[string]interface{}{"closed": false}),
+		throwOnError: throw,
+		strict:       strict,
+		bigInt:       bigInt,
+	}
+	return regObjectFuncs(fileStates[f], fn, interopFuncs(f))
+}
+
+// regObjectFuncs register the given functions on the fields of the JavaScript
+// object for the workbook or the derived object, these functions will be
+// released and replaced with the stub function which returns the file closed
+// error after the workbook closed, or the object closed error after the
+// derived object finished.
+func regObjectFuncs(st *fileState, fn map[string]interface{}, funcs map[string]func(this js.Value, args []js.Value) interface{}) js.Value {
+	for name, impl := range funcs {
+		fn[name] = st.export(impl)
+	}
+	obj := js.ValueOf(fn)
+	for name := range funcs {
+		st.props = append(st.props, funcProp{
+			obj: obj, key: js.ValueOf(name), stub: st.closedStub(), done: st.objectClosedStub(),
+		})
+	}
+	return obj
+}
+
+// releaseFile releases all the JavaScript functions registered for the
+// workbook, and replace the function fields of the JavaScript objects with
+// the stub functions.
+func releaseFile(f *excelize.File) {
+	st, ok := fileStates[f]
+	if !ok {
+		return
+	}
+	for h := range st.handles {
+		h.release(false, nil)
+	}
+	st.handles = nil
+	st.release(false, nil)
+	st.workbook.Set("closed", true)
+	delete(fileStates, f)
+}
+
+// regColsFunc register functions that implemented Cols interface, the
+// returned object is also a JavaScript async iterable which yields the rows
+// of each column. The given options will be used as the default options for
+// getting the rows of each column. The functions will be released after the
+// last column, the Next and Error functions keep returning the final results.
+func regColsFunc(f *excelize.File, cols *excelize.Cols, opts excelize.Options, fn map[string]interface{}) interface{} {
+	st := fileStates[f].handle()
+	finish := func() error {
+		st.finish(map[string]js.Value{
+			"Error": st.resultStub(ColsError(cols)),
+			"Next": st.resultStub(func(this js.Value, args []js.Value) interface{} {
+				return js.ValueOf(false)
+			}),
+		})
+		return cols.Error()
+	}
+	return regAsyncIterator(st, regObjectFuncs(st, fn, map[string]func(this js.Value, args []js.Value) interface{}{
+		"Error": ColsError(cols),
+		"Next": func(this js.Value, args []js.Value) interface{} {
+			next := ColsNext(cols)(this, args)
+			if !next.(js.Value).Bool() {
+				_ = finish()
+			}
+			return next
+		},
+		"Rows": ColsRows(cols, opts, st.strict),
+	}), func() (js.Value, bool, error) {
+		if !cols.Next() {
+			return js.Undefined(), true, cols.Error()
+		}
+		rows, err := cols.Rows(opts)
+		if err != nil {
+			return js.Undefined(), false, err
+		}
+		result := make([]interface{}, len(rows))
+		for i, cell := range rows {
+			result[i] = cell
+		}
+		return js.ValueOf(result), false, nil
+	}, finish)
+}
+
+// regMergeCellFunc register functions that implemented MergeCell interface,
+// the values of the merged cell are fixed, so these functions don't hold any
+// Go function.
+func regMergeCellFunc(f *excelize.File, mergeCell *excelize.MergeCell, fn map[string]interface{}) interface{} {
+	st := fileStates[f]
+	for name, value := range map[string]string{
+		"GetCellValue": mergeCell.GetCellValue(),
+		"GetStartAxis": mergeCell.GetStartAxis(),
+		"GetEndAxis":   mergeCell.GetEndAxis(),
 	} {
-		fn[name] = js.FuncOf(impl)
+		fn[name] = st.resultStub(func(this js.Value, args []js.Value) interface{} {
+			return js.ValueOf(value)
+		})
 	}
 	return js.ValueOf(fn)
 }
 
-// regMergeCellFunc register functions that implemented MergeCell interface.
-func regMergeCellFunc(mergeCell *excelize.MergeCell, fn map[string]interface{}) interface{} {
-	for name, impl := range map[string]func(this js.Value, args []js.Value) interface{}{
-		"GetCellValue": func(this js.Value, args []js.Value) interface{} {
-			return js.ValueOf(mergeCell.GetCellValue())
+// regRowsFunc register functions that implemented Rows interface, the
+// returned object is also a JavaScript async iterable which yields the
+// columns of each row. The iterator will be closed and the functions will be
+// released after the last row or the iterator closed, the Next, Error and
+// Close functions keep returning the final results.
+func regRowsFunc(f *excelize.File, rows *excelize.Rows, fn map[string]interface{}) interface{} {
+	st := fileStates[f].handle()
+	finish := func() error {
+		err := rows.Close()
+		st.finish(map[string]js.Value{
+			"Close": st.resultStub(func(this js.Value, args []js.Value) interface{} {
+				ret := map[string]interface{}{"error": nil}
+				if err != nil {
+					setError(ret, args, err)
+				}
+				return js.ValueOf(ret)
+			}),
+			"Error": st.resultStub(RowsError(rows)),
+			"Next": st.resultStub(func(this js.Value, args []js.Value) interface{} {
+				return js.ValueOf(false)
+			}),
+		})
+		return err
+	}
+	return regAsyncIterator(st, regObjectFuncs(st, fn, map[string]func(this js.Value, args []js.Value) interface{}{
+		"Close": func(this js.Value, args []js.Value) interface{} {
+			ret := RowsClose(rows)(this, args)
+			if len(args) == 0 {
+				_ = finish()
+			}
+			return ret
 		},
-		"GetStartAxis": func(this js.Value, args []js.Value) interface{} {
-			return js.ValueOf(mergeCell.GetStartAxis())
+		"Columns":    RowsColumns(rows, st.strict),
+		"Error":      RowsError(rows),
+		"GetRowOpts": RowsGetRowOpts(rows, st.bigInt),
+		"Next": func(this js.Value, args []js.Value) interface{} {
+			next := RowsNext(rows)(this, args)
+			if !next.(js.Value).Bool() {
+				_ = finish()
+			}
+			return next
 		},
-		"GetEndAxis": func(this js.Value, args []js.Value) interface{} {
-			return js.ValueOf(mergeCell.GetEndAxis())
+	}), func() (js.Value, bool, error) {
+		if !rows.Next() {
+			return js.Undefined(), true, rows.Error()
+		}
+		columns, err := rows.Columns()
+		if err != nil {
+			return js.Undefined(), false, err
+		}
+		result := make([]interface{}, len(columns))
+		for i, cell := range columns {
+			result[i] = cell
+		}
+		return js.ValueOf(result), false, nil
+	}, finish)
+}
+
+// regAsyncIterator set the Symbol.asyncIterator method on the given object,
+// each call of the iterator's next method invoke the given next function, the
+// given finish function will be invoked after the last value or an error, and
+// by the return method which called on break out of the loop.
+func regAsyncIterator(st *fileState, obj js.Value, next func() (js.Value, bool, error), finish func() error) js.Value {
+	promise := js.Global().Get("Promise")
+	settle := func(value js.Value, done bool, err error) interface{} {
+		if err != nil {
+			jsErr := js.Global().Get("Error").New(err.Error())
+			jsErr.Set("code", errorCode(err))
+			return promise.Call("reject", jsErr)
+		}
+		return promise.Call("resolve", js.ValueOf(map[string]interface{}{
+			"value": value, "done": done,
+		}))
+	}
+	iterator := js.ValueOf(map[string]interface{}{
+		"next": st.funcOf(func(this js.Value, args []js.Value) interface{} {
+			if st.busy() {
+				return settle(js.Undefined(), false, errWorkbookBusy)
+			}
+			value, done, err := next()
+			if done || err != nil {
+				if finishErr := finish(); err == nil {
+					err = finishErr
+				}
+			}
+			return settle(value, done, err)
+		}),
+		"return": st.funcOf(func(this js.Value, args []js.Value) interface{} {
+			if st.busy() {
+				return settle(js.Undefined(), false, errWorkbookBusy)
+			}
+			return settle(js.Undefined(), true, finish())
+		}),
+	})
+	asyncIterator := js.Global().Get("Symbol").Get("asyncIterator")
+	js.Global().Get("Reflect").Call("set", obj, asyncIterator,
+		st.funcOf(func(this js.Value, args []js.Value) interface{} {
+			return iterator
+		}))
+	st.props = append(st.props,
+		funcProp{obj: iterator, key: js.ValueOf("next"), stub: fileClosedPromiseFunc.Value, done: iteratorDonePromiseFunc.Value},
+		funcProp{obj: iterator, key: js.ValueOf("return"), stub: fileClosedPromiseFunc.Value, done: iteratorDonePromiseFunc.Value},
+		funcProp{obj: obj, key: asyncIterator, stub: fileClosedIteratorFunc.Value, done: iteratorDoneFunc.Value})
+	return obj
+}
+
+// regStreamWriterFunc register functions that implemented StreamWriter
+// interface, the functions will be released after the stream writer flushed.
+func regStreamWriterFunc(f *excelize.File, sw *excelize.StreamWriter, fn map[string]interface{}) interface{} {
+	st := fileStates[f].handle()
+	return regObjectFuncs(st, fn, map[string]func(this js.Value, args []js.Value) interface{}{
+		"AddTable": StreamAddTable(sw, st.strict),
+		"Flush": func(this js.Value, args []js.Value) interface{} {
+			ret := StreamFlush(sw)(this, args)
+			if len(args) == 0 {
+				st.finish(nil)
+			}
+			return ret
 		},
-	} {
-		fn[name] = js.FuncOf(impl)
-	}
-	return js.ValueOf(fn)
+		"InsertPageBreak": StreamInsertPageBreak(sw),
+		"MergeCell":       StreamMergeCell(sw),
+		"SetColWidth":     StreamSetColWidth(sw),
+		"SetPanes":        StreamSetPanes(sw, st.strict),
+		"SetRow":          StreamSetRow(sw, st.strict),
+	})
 }
 
 // inTypeSlice provides a method to check if an element is present in an
@@ -524,6 +1173,17 @@ func inTypeSlice(a []js.Type, x js.Type) int {
 	return -1
 }
 
+// inStrSlice provides a method to check if an element is present in a string
+// array, and return the index of its location, otherwise return -1.
+func inStrSlice(a []string, x string) int {
+	for idx, n := range a {
+		if x == n {
+			return idx
+		}
+	}
+	return -1
+}
+
 // jsToGoBaseType convert JavaScript value to Go basic data type variable.
 func jsToGoBaseType(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
 	fn, ok := jsToBaseGoTypeFuncs[kind]
@@ -535,133 +1195,275 @@ func jsToGoBaseType(jsVal js.Value, kind reflect.Kind) (reflect.Value, error) {
 
 // jsValueToGo convert JavaScript object to Go variable base on the given Go
 // structure types, this function extract each fields of the structure from
-// object recursively.
-func jsValueToGo(jsVal js.Value, goType reflect.Type) (reflect.Value, error) {
-	result := reflect.New(goType)
+// object recursively. In the strict mode, the fields of the object which not
+// in the structure or the given extra fields, and the invalid enumeration
+// values will be reported as errors. The option validation mode should be
+// captured when the function call starts, such as the mode of the workbook.
+func jsValueToGo(jsVal js.Value, goType reflect.Type, strict bool, extraFields ...string) (result reflect.Value, err error) {
+	result = reflect.New(goType)
 	s := result.Elem()
+	var path string
+	defer func() {
+		if err != nil {
+			err = withArgPath(err, path, jsVal)
+		}
+	}()
+	if path, err = checkOptionFields(jsVal, goType, strict, extraFields...); err != nil {
+		return result, err
+	}
 
 	for resultFieldIdx := 0; resultFieldIdx < s.NumField(); resultFieldIdx++ {
 		field := goType.Field(resultFieldIdx)
-		if goBaseTypes[field.Type.Kind()] {
-			jsBaseVal := jsVal.Get(field.Name)
-			if jsBaseVal.Type() != js.TypeUndefined {
-				goBaseVal, err := jsToGoBaseType(jsBaseVal, field.Type.Kind())
-				if err != nil {
-					return result, err
-				}
-				s.Field(resultFieldIdx).Set(goBaseVal.Convert(s.Field(resultFieldIdx).Type()))
-			}
+		if !field.IsExported() {
 			continue
 		}
-		switch field.Type.Kind() {
-		case reflect.Ptr:
-			// Pointer of the Go data type, for example: *excelize.Options or *string
-			ptrType := field.Type.Elem()
-			if !goBaseTypes[ptrType.Kind()] {
-				// Pointer of the Go struct, for example: *excelize.Options
-				jsObjVal := jsVal.Get(field.Name)
-				if jsObjVal.Type() != js.TypeUndefined {
-					if jsObjVal.Type() != js.TypeObject {
-						return result, errArgType
-					}
-					v, err := jsValueToGo(jsObjVal, ptrType)
-					if err != nil {
-						return result, err
-					}
-					s.Field(resultFieldIdx).Set(v)
-				}
+		path = field.Name
+		jsFieldVal := jsVal.Get(field.Name)
+		if jsType(jsFieldVal) == js.TypeUndefined {
+			continue
+		}
+		v, err := jsToGoValue(jsFieldVal, field.Type, strict)
+		if err != nil {
+			return result, err
+		}
+		if err = checkOptionEnum(goType, field.Name, v, strict); err != nil {
+			return result, err
+		}
+		s.Field(resultFieldIdx).Set(v)
+	}
+	return result, nil
+}
+
+// checkOptionFields checks the fields of the given JavaScript object in the
+// strict mode, it returns the name of the first field which not in the
+// exported fields of the given Go structure type and the extra fields with
+// an error.
+func checkOptionFields(jsVal js.Value, goType reflect.Type, strict bool, extraFields ...string) (string, error) {
+	if !strict || jsType(jsVal) != js.TypeObject {
+		return "", nil
+	}
+	keys := js.Global().Get("Object").Call("keys", jsVal)
+	for i := 0; i < keys.Length(); i++ {
+		key := keys.Index(i).String()
+		if field, ok := goType.FieldByName(key); ok && field.IsExported() {
+			continue
+		}
+		if inStrSlice(extraFields, key) == -1 {
+			return key, errArgField
+		}
+	}
+	return "", nil
+}
+
+// checkOptionEnum checks the value of the enumeration option field by given
+// Go structure type and field name in the strict mode, the empty string will
+// be treated as the default value of the field.
+func checkOptionEnum(goType reflect.Type, name string, v reflect.Value, strict bool) error {
+	enums, ok := optionEnums[goType][name]
+	if !strict || !ok {
+		return nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.String() == "" {
+		return nil
+	}
+	for _, enum := range enums {
+		if strings.EqualFold(enum, v.String()) {
+			return nil
+		}
+	}
+	return errArgValue
+}
+
+// checkOptionNumber checks the JavaScript number for the Go integer, unsigned
+// integer, float and enumeration data types in the strict mode, the number
+// should be an integer for the integer data types and in the range of the
+// data type.
+func checkOptionNumber(jsVal js.Value, goType reflect.Type, strict bool) error {
+	if !strict || jsType(jsVal) != js.TypeNumber {
+		return nil
+	}
+	num := jsVal.Float()
+	switch goType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if num != math.Trunc(num) || num < math.MinInt64 || num >= math.MaxInt64 ||
+			reflect.Zero(goType).OverflowInt(int64(num)) {
+			return errArgValue
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if num != math.Trunc(num) || num < 0 || num >= math.MaxUint64 ||
+			reflect.Zero(goType).OverflowUint(uint64(num)) {
+			return errArgValue
+		}
+		if max, ok := optionEnumTypes[goType]; ok && uint64(num) > max {
+			return errArgValue
+		}
+	case reflect.Float32:
+		if reflect.Zero(goType).OverflowFloat(num) {
+			return errArgValue
+		}
+	}
+	return nil
+}
+
+// jsToGoValue convert JavaScript value to Go value base on the given Go data
+// type, this function supports the Go basic data types, pointer, structure,
+// slice, array, map with string keys and interface recursively.
+func jsToGoValue(jsVal js.Value, goType reflect.Type, strict bool) (reflect.Value, error) {
+	if goBaseTypes[goType.Kind()] {
+		v, err := jsToGoBaseType(jsVal, goType.Kind())
+		if err != nil {
+			return v, err
+		}
+		return v.Convert(goType), checkOptionNumber(jsVal, goType, strict)
+	}
+	// The null value will be converted to the zero value of the Go data type
+	if jsVal.IsNull() && goType != reflect.TypeOf(time.Time{}) {
+		return reflect.Zero(goType), nil
+	}
+	switch goType.Kind() {
+	case reflect.Ptr:
+		// Pointer of the Go data type, for example: *excelize.Options or *string
+		v, err := jsToGoValue(jsVal, goType.Elem(), strict)
+		if err != nil {
+			return v, err
+		}
+		x := reflect.New(goType.Elem())
+		x.Elem().Set(v)
+		return x, nil
+	case reflect.Struct:
+		// The Go struct, for example: excelize.Options, convert sub fields recursively
+		if goType == reflect.TypeOf(time.Time{}) {
+			if !isJSDate(jsVal) {
+				return reflect.ValueOf(nil), errArgType
 			}
-			if goBaseTypes[ptrType.Kind()] {
-				// Pointer of the Go basic data type, for example: *string
-				jsBaseVal := jsVal.Get(field.Name)
-				if jsBaseVal.Type() != js.TypeUndefined {
-					v, err := jsToGoBaseType(jsBaseVal, ptrType.Kind())
-					if err != nil {
-						return result, err
-					}
-					x := reflect.New(ptrType)
-					x.Elem().Set(v)
-					s.Field(resultFieldIdx).Set(x.Elem().Addr())
+			return reflect.ValueOf(jsDateToTime(jsVal)), nil
+		}
+		if jsType(jsVal) != js.TypeObject {
+			return reflect.ValueOf(nil), errArgType
+		}
+		v, err := jsValueToGo(jsVal, goType, strict)
+		if err != nil {
+			return v, err
+		}
+		return v.Elem(), nil
+	case reflect.Slice, reflect.Array:
+		// The Go data type array, for example: []*excelize.Options,
+		// []excelize.Options, []string, []*string or [][]string
+		if jsType(jsVal) != js.TypeObject {
+			return reflect.ValueOf(nil), errArgType
+		}
+		result := reflect.New(goType).Elem()
+		if goType.Kind() == reflect.Slice {
+			if goType.Elem().Kind() == reflect.Uint8 {
+				if buf, err := jsBytesToGo(jsVal); err == nil {
+					return reflect.ValueOf(buf).Convert(goType), nil
 				}
 			}
-		case reflect.Struct:
-			// The Go struct, for example: excelize.Options, convert sub fields recursively
-			structType := field.Type
-			jsObjVal := jsVal.Get(field.Name)
-			if jsObjVal.Type() != js.TypeUndefined {
-				if jsObjVal.Type() != js.TypeObject {
-					return result, errArgType
-				}
-				v, err := jsValueToGo(jsObjVal, structType)
+			result = reflect.MakeSlice(goType, jsVal.Length(), jsVal.Length())
+		} else if jsVal.Length() > goType.Len() {
+			return reflect.ValueOf(nil), errArgType
+		}
+		for i := 0; i < jsVal.Length(); i++ {
+			v, err := jsToGoValue(jsVal.Index(i), goType.Elem(), strict)
+			if err != nil {
+				return v, withArgPath(err, "["+strconv.Itoa(i)+"]", jsVal)
+			}
+			result.Index(i).Set(v)
+		}
+		return result, nil
+	case reflect.Map:
+		// The Go map with string keys, for example: map[string]string
+		if goType.Key().Kind() != reflect.String || jsType(jsVal) != js.TypeObject {
+			return reflect.ValueOf(nil), errArgType
+		}
+		result := reflect.MakeMap(goType)
+		keys := js.Global().Get("Object").Call("keys", jsVal)
+		for i := 0; i < keys.Length(); i++ {
+			key := keys.Index(i).String()
+			v, err := jsToGoValue(jsVal.Get(key), goType.Elem(), strict)
+			if err != nil {
+				return v, withArgPath(err, key, jsVal)
+			}
+			result.SetMapIndex(reflect.ValueOf(key).Convert(goType.Key()), v)
+		}
+		return result, nil
+	case reflect.Interface:
+		// The Go interface, for example: interface{}, the data type of the
+		// value will be inferred by the JavaScript value
+		result := reflect.New(goType).Elem()
+		goVal, err := jsToGoInterface(jsVal)
+		if err != nil || goVal == nil {
+			return result, err
+		}
+		v := reflect.ValueOf(goVal)
+		if !v.Type().AssignableTo(goType) {
+			return reflect.ValueOf(nil), errArgType
+		}
+		result.Set(v)
+		return result, nil
+	}
+	return reflect.ValueOf(nil), errArgType
+}
+
+// jsToGoInterface convert JavaScript value to Go value by inferring the data
+// type of the value at runtime. The boolean, number and string will be
+// converted to bool, float64 and string, the Date will be converted to
+// time.Time, the Uint8Array will be converted to []byte, and the array and
+// object will be converted to []interface{} and map[string]interface{}
+// recursively.
+func jsToGoInterface(jsVal js.Value) (interface{}, error) {
+	switch jsType(jsVal) {
+	case js.TypeUndefined, js.TypeNull:
+		return nil, nil
+	case js.TypeBoolean:
+		return jsVal.Bool(), nil
+	case js.TypeNumber:
+		return jsVal.Float(), nil
+	case jsTypeBigInt:
+		if v := jsBigIntToCellValue(jsVal); v != nil {
+			return v, nil
+		}
+	case js.TypeString:
+		return jsVal.String(), nil
+	case js.TypeObject:
+		if isJSDate(jsVal) {
+			return jsDateToTime(jsVal), nil
+		}
+		if jsVal.InstanceOf(js.Global().Get("Uint8Array")) {
+			buf := make([]byte, jsVal.Length())
+			js.CopyBytesToGo(buf, jsVal)
+			return buf, nil
+		}
+		if js.Global().Get("Array").Call("isArray", jsVal).Bool() {
+			result := make([]interface{}, jsVal.Length())
+			for i := range result {
+				v, err := jsToGoInterface(jsVal.Index(i))
 				if err != nil {
-					return result, err
-				}
-				s.Field(resultFieldIdx).Set(v.Elem())
-			}
-		case reflect.Slice:
-			// The Go data type array, for example:
-			// []*excelize.Options, []excelize.Options, []string, []*string
-			ele := field.Type.Elem()
-			jsArray := jsVal.Get(field.Name)
-			if jsArray.Type() != js.TypeUndefined {
-				if jsArray.Type() != js.TypeObject {
-					return result, errArgType
-				}
-				if ele.Kind() == reflect.Ptr {
-					// Pointer array of the Go data type, for example: []*excelize.Options or []*string
-					subEle := ele.Elem()
-					for i := 0; i < jsArray.Length(); i++ {
-						if goBaseTypes[subEle.Kind()] {
-							// Pointer array of the Go basic data type, for example: []*string
-							v, err := jsToGoBaseType(jsArray.Index(i), subEle.Kind())
-							if err != nil {
-								return result, err
-							}
-							x := reflect.New(subEle)
-							x.Elem().Set(v)
-							s.Field(resultFieldIdx).Set(reflect.Append(s.Field(resultFieldIdx), x.Elem().Addr()))
-						} else {
-							// Pointer array of the Go struct, for example: []*excelize.Options
-							v, err := jsValueToGo(jsArray.Index(i), subEle)
-							if err != nil {
-								return result, err
-							}
-							x := reflect.New(subEle)
-							x.Elem().Set(v.Elem())
-							s.Field(resultFieldIdx).Set(reflect.Append(s.Field(resultFieldIdx), x.Elem().Addr()))
-						}
-					}
-				} else {
-					// The Go data type array, for example: []excelize.Options or []string
-					subEle := ele
-					for i := 0; i < jsArray.Length(); i++ {
-						if subEle.Kind() == reflect.Uint8 { // []byte
-							buf := make([]byte, jsArray.Length())
-							js.CopyBytesToGo(buf, jsArray)
-							s.Field(resultFieldIdx).Set(reflect.ValueOf(buf))
-							break
-						}
-						if goBaseTypes[subEle.Kind()] {
-							// The Go basic data type array, for example: []string
-							v, err := jsToGoBaseType(jsArray.Index(i), subEle.Kind())
-							if err != nil {
-								return result, err
-							}
-							s.Field(resultFieldIdx).Set(reflect.Append(s.Field(resultFieldIdx), v))
-						} else {
-							// The Go struct array, for example: []excelize.Options
-							v, err := jsValueToGo(jsArray.Index(i), subEle)
-							if err != nil {
-								return result, err
-							}
-							s.Field(resultFieldIdx).Set(reflect.Append(s.Field(resultFieldIdx), v.Elem()))
-						}
-					}
+					return nil, withArgPath(err, "["+strconv.Itoa(i)+"]", jsVal)
 				}
+				result[i] = v
+			}
+			return result, nil
+		}
+		result := map[string]interface{}{}
+		keys := js.Global().Get("Object").Call("keys", jsVal)
+		for i := 0; i < keys.Length(); i++ {
+			key := keys.Index(i).String()
+			v, err := jsToGoInterface(jsVal.Get(key))
+			if err != nil {
+				return nil, withArgPath(err, key, jsVal)
 			}
+			result[key] = v
 		}
+		return result, nil
 	}
-	return result, nil
+	return nil, errArgType
 }
 
 // goBaseTypeToJS convert Go basic data type value to JavaScript variable.
@@ -675,144 +1477,436 @@ func goBaseTypeToJS(goVal reflect.Value, kind reflect.Kind) (interface{}, error)
 
 // goValueToJS convert Go variable to JavaScript object base on the given Go
 // structure types, this function extract each fields of the structure from
-// structure variable recursively.
-func goValueToJS(goVal reflect.Value, goType reflect.Type) (map[string]interface{}, error) {
+// structure variable recursively. The int64 and uint64 values will be
+// converted to JavaScript BigInt if the bigInt parameter is true, such as in
+// the BigInt mode of the workbook.
+func goValueToJS(goVal reflect.Value, goType reflect.Type, bigInt bool) (map[string]interface{}, error) {
 	result := map[string]interface{}{}
-	s := reflect.New(goType).Elem()
-	for i := 0; i < s.NumField(); i++ {
-		field := s.Type().Field(i)
-		if goBaseTypes[s.Field(i).Kind()] {
-			v, err := goBaseTypeToJS(goVal.Field(i), s.Field(i).Kind())
-			if err != nil {
-				return nil, err
-			}
-			result[field.Name] = v
-			continue
+	if goVal.Kind() != reflect.Struct || goVal.NumField() != goType.NumField() {
+		return nil, errArgType
+	}
+	for i := 0; i < goType.NumField(); i++ {
+		field := goType.Field(i)
+		if !field.IsExported() {
+			continue
 		}
-		switch s.Field(i).Kind() {
-		case reflect.Ptr:
-			// Pointer of the Go data type, for example: *excelize.Options or *string
-			ptrType := field.Type.Elem()
-			if !goBaseTypes[ptrType.Kind()] {
-				// Pointer of the Go struct, for example: *excelize.Options
-				goStructVal := goVal.Field(i)
-				if !goStructVal.IsNil() {
-					v, err := goValueToJS(goStructVal.Elem(), ptrType)
-					if err != nil {
-						return nil, err
-					}
-					result[field.Name] = v
-				}
+		v, ok, err := goToJSValue(goVal.Field(i), field.Type, bigInt)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result[field.Name] = v
+		}
+	}
+	return result, nil
+}
+
+// goToJSValue convert Go value to JavaScript variable base on the given Go
+// data type, this function supports the Go basic data types, pointer,
+// structure, slice, array, map with string keys and interface recursively.
+// The nil pointer, nil interface, zero structure, empty slice and empty map
+// will be omitted.
+func goToJSValue(goVal reflect.Value, goType reflect.Type, bigInt bool) (interface{}, bool, error) {
+	if goBaseTypes[goType.Kind()] {
+		if kind := goType.Kind(); bigInt && goVal.Kind() == kind && (kind == reflect.Int64 || kind == reflect.Uint64) {
+			return goIntToJSBigInt(goVal), true, nil
+		}
+		v, err := goBaseTypeToJS(goVal, goType.Kind())
+		return v, err == nil, err
+	}
+	if goVal.Kind() != goType.Kind() {
+		return nil, false, errArgType
+	}
+	switch goType.Kind() {
+	case reflect.Ptr:
+		// Pointer of the Go data type, for example: *excelize.Options or *string
+		if goVal.IsNil() {
+			return nil, false, nil
+		}
+		return goToJSValue(goVal.Elem(), goType.Elem(), bigInt)
+	case reflect.Struct:
+		// The Go struct, for example: excelize.Options, convert sub fields recursively
+		if goVal.IsZero() {
+			return nil, false, nil
+		}
+		if t, ok := goVal.Interface().(time.Time); ok {
+			return timeToJSDate(t), true, nil
+		}
+		v, err := goValueToJS(goVal, goType, bigInt)
+		return v, err == nil, err
+	case reflect.Slice, reflect.Array:
+		// The Go data type array, for example: []*excelize.Options,
+		// []excelize.Options, []string, []*string or [][]string
+		if goVal.Len() == 0 {
+			return nil, false, nil
+		}
+		if goType.Kind() == reflect.Slice && goType.Elem().Kind() == reflect.Uint8 { // []byte
+			dst := js.Global().Get("Uint8Array").New(goVal.Len())
+			js.CopyBytesToJS(dst, goVal.Bytes())
+			return dst, true, nil
+		}
+		result := make([]interface{}, goVal.Len())
+		for i := range result {
+			v, _, err := goToJSValue(goVal.Index(i), goType.Elem(), bigInt)
+			if err != nil {
+				return nil, false, err
 			}
-			if goBaseTypes[ptrType.Kind()] {
-				// Pointer of the Go basic data type, for example: *string
-				goBaseVal := goVal.Field(i)
-				if !goBaseVal.IsNil() {
-					v, err := goBaseTypeToJS(goBaseVal.Elem(), ptrType.Kind())
-					if err != nil {
-						return nil, err
-					}
-					result[field.Name] = v
-				}
+			result[i] = v
+		}
+		return result, true, nil
+	case reflect.Map:
+		// The Go map with string keys, for example: map[string]string
+		if goType.Key().Kind() != reflect.String {
+			return nil, false, errArgType
+		}
+		if goVal.Len() == 0 {
+			return nil, false, nil
+		}
+		result := map[string]interface{}{}
+		iter := goVal.MapRange()
+		for iter.Next() {
+			v, ok, err := goToJSValue(iter.Value(), goType.Elem(), bigInt)
+			if err != nil {
+				return nil, false, err
 			}
-		case reflect.Struct:
-			// The Go struct, for example: excelize.Options, convert sub fields recursively
-			structType := field.Type
-			goStructVal := goVal.Field(i)
-			if !goStructVal.IsZero() {
-				v, err := goValueToJS(goStructVal, structType)
-				if err != nil {
-					return nil, err
-				}
-				result[field.Name] = v
-			}
-		case reflect.Slice:
-			// The Go data type array, for example:
-			// []*excelize.Options, []excelize.Options, []string, []*string
-			ele := field.Type.Elem()
-			goSlice := goVal.Field(i)
-			for s := 0; s < goSlice.Len(); s++ {
-				if ele.Kind() == reflect.Ptr {
-					// Pointer array of the Go data type, for example: []*excelize.Options or []*string
-					subEle := ele.Elem()
-					if !goBaseTypes[subEle.Kind()] {
-						// Pointer of the Go struct, for example: *excelize.Options
-						goStructVal := goSlice.Index(s)
-						if !goStructVal.IsNil() {
-							v, err := goValueToJS(goStructVal.Elem(), subEle)
-							if err != nil {
-								return nil, err
-							}
-							if _, ok := result[field.Name]; !ok {
-								result[field.Name] = []interface{}{}
-							}
-							x := result[field.Name].([]interface{})
-							x = append(x, v)
-							result[field.Name] = x
-						}
-					}
-					if goBaseTypes[subEle.Kind()] {
-						// Pointer of the Go basic data type, for example: *string
-						goBaseVal := goSlice.Index(s)
-						if !goBaseVal.IsNil() {
-							v, err := goBaseTypeToJS(goBaseVal.Elem(), subEle.Kind())
-							if err != nil {
-								return nil, err
-							}
-							if _, ok := result[field.Name]; !ok {
-								result[field.Name] = []interface{}{}
-							}
-							x := result[field.Name].([]interface{})
-							x = append(x, v)
-							result[field.Name] = x
-						}
-					}
-				} else {
-					// The Go data type array, for example: []excelize.Options or []string
-					subEle := ele
-					if !goBaseTypes[subEle.Kind()] {
-						// Value of the Go struct, for example: excelize.Options
-						goStructVal := goSlice.Index(s)
-						if !goStructVal.IsZero() {
-							v, err := goValueToJS(goStructVal, subEle)
-							if err != nil {
-								return nil, err
-							}
-							if _, ok := result[field.Name]; !ok {
-								result[field.Name] = []interface{}{}
-							}
-							x := result[field.Name].([]interface{})
-							x = append(x, v)
-							result[field.Name] = x
-						}
-					}
-					if goBaseTypes[subEle.Kind()] {
-						// Value of the Go basic data type, for example: string
-						goBaseVal := goSlice.Index(s)
-						if !goBaseVal.IsZero() {
-							if subEle.Kind() == reflect.Uint8 { // []byte
-								dst := js.Global().Get("Uint8Array").New(goSlice.Len())
-								js.CopyBytesToJS(dst, goSlice.Bytes())
-								result[field.Name] = dst
-								break
-							}
-							v, err := goBaseTypeToJS(goBaseVal, subEle.Kind())
-							if err != nil {
-								return nil, err
-							}
-							if _, ok := result[field.Name]; !ok {
-								result[field.Name] = []interface{}{}
-							}
-							x := result[field.Name].([]interface{})
-							x = append(x, v)
-							result[field.Name] = x
-						}
-					}
+			if ok {
+				result[iter.Key().String()] = v
+			}
+		}
+		return result, true, nil
+	case reflect.Interface:
+		// The Go interface, for example: interface{}, convert the underlying
+		// value by its dynamic data type
+		if goVal.IsNil() {
+			return nil, false, nil
+		}
+		return goToJSValue(goVal.Elem(), goVal.Elem().Type(), bigInt)
+	}
+	return nil, false, errArgType
+}
+
+// goIntToJSBigInt converts Go int64 or uint64 value to JavaScript BigInt.
+func goIntToJSBigInt(goVal reflect.Value) js.Value {
+	if goVal.Kind() == reflect.Uint64 {
+		return js.Global().Get("BigInt").Invoke(strconv.FormatUint(goVal.Uint(), 10))
+	}
+	return js.Global().Get("BigInt").Invoke(strconv.FormatInt(goVal.Int(), 10))
+}
+
+// goResultToJS convert the Go value returned by the excelize function to the
+// JavaScript value for the result of the wrapper function. The empty slice
+// and array will be converted to an empty array, and the empty map and
+// structure will be converted to an empty object instead of omitted. The int64
+// and uint64 values will be converted to JavaScript BigInt if the bigInt
+// parameter is true.
+func goResultToJS(goVal interface{}, bigInt bool) (interface{}, error) {
+	v := reflect.ValueOf(goVal)
+	result, ok, err := goToJSValue(v, v.Type(), bigInt)
+	if err != nil || ok {
+		return result, err
+	}
+	t := v.Type()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return []interface{}{}, nil
+	case reflect.Map, reflect.Struct:
+		return map[string]interface{}{}, nil
+	}
+	return nil, nil
+}
+
+// withArgPath returns an argument error with the option field path by given
+// error, the path of the field which caused the error, and the JavaScript
+// object which contains the field.
+func withArgPath(err error, path string, jsVal js.Value) error {
+	var argErr *argError
+	if errors.As(err, &argErr) && argErr.index == -1 {
+		if strings.HasPrefix(argErr.path, "[") {
+			path += argErr.path
+		} else if argErr.path != "" {
+			path += "." + argErr.path
+		}
+		return &argError{err: argErr.err, index: -1, path: path, value: jsVal}
+	}
+	return &argError{err: err, index: -1, path: path, value: jsVal}
+}
+
+// errorCode returns the stable error code of the given error, it returns
+// "ERR_UNKNOWN" if the error doesn't have a stable error code.
+func errorCode(err error) string {
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			return e.code
+		}
+	}
+	var errSheetNotExist excelize.ErrSheetNotExist
+	if errors.As(err, &errSheetNotExist) {
+		return "ERR_SHEET_NOT_EXIST"
+	}
+	return "ERR_UNKNOWN"
+}
+
+// newErrorInfo returns the structured error information of the given error
+// which returned by the wrapper function, includes the stable error code, the
+// error message, the index of the failing argument and the path of the
+// failing option field.
+func newErrorInfo(args []js.Value, err error) map[string]interface{} {
+	info := map[string]interface{}{
+		"code": errorCode(err), "message": err.Error(), "argIndex": nil, "path": nil,
+	}
+	var argErr *argError
+	if errors.As(err, &argErr) {
+		if argErr.index == -1 {
+			for i, arg := range args {
+				if arg.Equal(argErr.value) {
+					info["argIndex"] = i
+					break
 				}
 			}
+		} else {
+			info["argIndex"] = argErr.index
+		}
+		if argErr.path != "" {
+			info["path"] = argErr.path
 		}
 	}
-	return result, nil
+	return info
+}
+
+// setError sets the error message and the structured error information of the
+// given error to the result of the wrapper function, the error message is kept
+// in the "error" field for compatibility.
+func setError(ret map[string]interface{}, args []js.Value, err error) {
+	ret["error"], ret["errorInfo"] = err.Error(), newErrorInfo(args, err)
+}
+
+// fileClosed returns the file closed error for the functions of the closed
+// workbook.
+func fileClosed(this js.Value, args []js.Value) interface{} {
+	ret := map[string]interface{}{"error": nil}
+	setError(ret, args, errFileClosed)
+	return js.ValueOf(ret)
+}
+
+// objectClosed returns the object closed error for the functions of the
+// finished iterator or the flushed stream writer.
+func objectClosed(this js.Value, args []js.Value) interface{} {
+	ret := map[string]interface{}{"error": nil}
+	setError(ret, args, errObjectClosed)
+	return js.ValueOf(ret)
+}
+
+// workbookBusy returns the workbook busy error for the functions of the
+// workbook which is being written to the stream asynchronously.
+func workbookBusy(this js.Value, args []js.Value) interface{} {
+	ret := map[string]interface{}{"error": nil}
+	setError(ret, args, errWorkbookBusy)
+	return js.ValueOf(ret)
+}
+
+// newErrorClass creates the ExcelizeError class, a subclass of the
+// JavaScript Error, the constructor accepts the error message and an optional
+// error information object.
+func newErrorClass() js.Value {
+	proto := js.Global().Get("Object").Call("create", js.Global().Get("Error").Get("prototype"))
+	class := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		jsErr := js.Global().Get("Error").New()
+		if len(args) > 0 {
+			jsErr = js.Global().Get("Error").New(args[0])
+		}
+		js.Global().Get("Object").Call("setPrototypeOf", jsErr, proto)
+		for _, key := range []string{"code", "argIndex", "path"} {
+			if len(args) > 1 && jsType(args[1]) == js.TypeObject {
+				jsErr.Set(key, args[1].Get(key))
+			}
+		}
+		return jsErr
+	})
+	proto.Set("constructor", class)
+	proto.Set("name", "ExcelizeError")
+	class.Set("prototype", proto)
+	js.Global().Get("Object").Call("setPrototypeOf", class, js.Global().Get("Error"))
+	return class.Value
+}
+
+// newExcelizeError creates an ExcelizeError by given error information.
+func newExcelizeError(info js.Value) js.Value {
+	return excelizeError.New(info.Get("message"), info)
+}
+
+// throwable returns the function for the exception mode by given function,
+// which returns the ExcelizeError if the result object with an error, or
+// returns the payload of the result object directly. The result object
+// without payload will be returned as undefined, and the result object with
+// the only one field will be returned as the value of the field.
+func throwable(fn func(this js.Value, args []js.Value) interface{}) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret, ok := fn(this, args).(js.Value)
+		if !ok || jsType(ret) != js.TypeObject || ret.Get("error").IsUndefined() {
+			return ret
+		}
+		if jsType(ret.Get("error")) == js.TypeString {
+			return newExcelizeError(ret.Get("errorInfo"))
+		}
+		ret.Delete("error")
+		ret.Delete("errorInfo")
+		keys := js.Global().Get("Object").Call("keys", ret)
+		switch keys.Length() {
+		case 0:
+			return js.Undefined()
+		case 1:
+			if value := ret.Get(keys.Index(0).String()); jsType(value) != js.TypeFunction {
+				return value
+			}
+		}
+		return ret
+	}
+}
+
+// throwIfError returns the JavaScript function which calls the given function
+// and throws the returned ExcelizeError.
+func throwIfError(fn js.Value) js.Value {
+	return throwIfErrorFunc.Invoke(fn, excelizeError)
+}
+
+// fileModeOptions returns the values of the ThrowOnError, Strict and BigInt
+// fields in the given options, the global error handling mode and option
+// validation mode will be returned if they not set, and the BigInt mode is
+// disabled by default.
+func fileModeOptions(opts js.Value) (throw, strict, bigInt bool, err error) {
+	throw, strict = throwOnError, strictMode
+	for _, opt := range []struct {
+		name  string
+		value *bool
+	}{{"ThrowOnError", &throw}, {"Strict", &strict}, {"BigInt", &bigInt}} {
+		switch v := opts.Get(opt.name); jsType(v) {
+		case js.TypeUndefined:
+		case js.TypeBoolean:
+			*opt.value = v.Bool()
+		default:
+			return false, false, false, &argError{err: errArgType, index: -1, path: opt.name, value: opts}
+		}
+	}
+	return throw, strict, bigInt, nil
+}
+
+// progressTracker reports the progress of the long-running function call to
+// the OnProgress callback function of the options, and checks the AbortSignal
+// of the Signal option for the cancellation.
+type progressTracker struct {
+	onProgress, signal js.Value
+	failed             bool
+}
+
+// progressOptions returns the progress tracker by the OnProgress and Signal
+// fields of the given options, and returns nil if neither of them specified.
+func progressOptions(opts js.Value) (*progressTracker, error) {
+	p := &progressTracker{onProgress: opts.Get("OnProgress"), signal: opts.Get("Signal")}
+	if typ := jsType(p.onProgress); typ != js.TypeUndefined && typ != js.TypeFunction {
+		return nil, &argError{err: errArgType, index: -1, path: "OnProgress", value: opts}
+	}
+	if typ := jsType(p.signal); typ != js.TypeUndefined &&
+		(typ != js.TypeObject || jsType(p.signal.Get("aborted")) != js.TypeBoolean) {
+		return nil, &argError{err: errArgType, index: -1, path: "Signal", value: opts}
+	}
+	if p.onProgress.IsUndefined() && p.signal.IsUndefined() {
+		return nil, nil
+	}
+	return p, nil
+}
+
+// report calls the OnProgress callback function with the given phase, the
+// number of the done and total steps, the total will be 0 if it's unknown. It
+// returns the cancelled error if the signal has been aborted, including
+// aborted by the callback function, or the error thrown by the callback
+// function. A nil tracker reports nothing.
+func (p *progressTracker) report(phase string, done, total int) error {
+	if p == nil {
+		return nil
+	}
+	if p.failed || (!p.signal.IsUndefined() && p.signal.Get("aborted").Bool()) {
+		p.failed = true
+		return errCancelled
+	}
+	if !p.onProgress.IsUndefined() {
+		if _, err := invokeJS(func() js.Value { return p.onProgress.Invoke(phase, done, total) }); err != nil {
+			p.failed = true
+			return err
+		}
+	}
+	if !p.signal.IsUndefined() && p.signal.Get("aborted").Bool() {
+		p.failed = true
+		return errCancelled
+	}
+	return nil
+}
+
+// progressReader is an io.Reader which reports the number of bytes read from
+// the underlying reader in the given phase.
+type progressReader struct {
+	r        io.Reader
+	p        *progressTracker
+	phase    string
+	n, total int
+}
+
+// Read reads from the underlying reader and reports the progress.
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	r.n += n
+	if err == nil {
+		err = r.p.report(r.phase, r.n, r.total)
+	}
+	return n, err
+}
+
+// progressZipWriter is an excelize.ZipWriter which reports the number of the
+// parts written to the zip archive, and stops writing on cancellation.
+type progressZipWriter struct {
+	excelize.ZipWriter
+	f           *excelize.File
+	p           *progressTracker
+	done, total int
+}
+
+// newProgressZipWriter returns the zip writer function which wraps the zip
+// writer created by the given function to report the progress of writing
+// the workbook, the given function will be returned for a nil tracker.
+func newProgressZipWriter(f *excelize.File, p *progressTracker, fn func(io.Writer) excelize.ZipWriter) func(io.Writer) excelize.ZipWriter {
+	if p == nil {
+		return fn
+	}
+	return func(w io.Writer) excelize.ZipWriter {
+		return &progressZipWriter{ZipWriter: fn(w), f: f, p: p}
+	}
+}
+
+// Create reports the progress before adding the part to the zip archive. The
+// total number of the parts will be counted on the first part created, after
+// the workbook serialized to the package.
+func (zw *progressZipWriter) Create(name string) (io.Writer, error) {
+	if zw.total == 0 {
+		zw.f.Pkg.Range(func(key, value interface{}) bool {
+			zw.total++
+			return true
+		})
+	}
+	if err := zw.p.report("zip", zw.done, max(zw.total, zw.done+1)); err != nil {
+		return nil, err
+	}
+	zw.done++
+	return zw.ZipWriter.Create(name)
+}
+
+// Close closes the zip archive and reports the completion of writing.
+func (zw *progressZipWriter) Close() error {
+	if err := zw.ZipWriter.Close(); err != nil || zw.p.failed {
+		return err
+	}
+	return zw.p.report("zip", zw.done, zw.done)
 }
 
 // prepareArgs provides a method to check the excelize wrapper function
@@ -830,24 +1924,507 @@ func prepareArgs(args []js.Value, types []argsRule) error {
 			return nil
 		}
 		excepted, received := types[i], args[i]
-		if inTypeSlice(excepted.types, received.Type()) == -1 {
-			return errArgType
+		if inTypeSlice(excepted.types, jsType(received)) == -1 {
+			return &argError{err: errArgType, index: i}
+		}
+	}
+	return nil
+}
+
+// jsType returns the type of the given JavaScript value. The Type function of
+// the syscall/js package doesn't support the BigInt value and panics on it,
+// so the jsTypeBigInt will be returned for the BigInt value.
+func jsType(jsVal js.Value) (typ js.Type) {
+	defer func() {
+		if recover() != nil {
+			typ = jsTypeBigInt
 		}
+	}()
+	return jsVal.Type()
+}
+
+// jsBigIntToInt64 converts JavaScript BigInt value to Go int64, it returns an
+// error if the value overflows int64.
+func jsBigIntToInt64(jsVal js.Value) (int64, error) {
+	v, err := strconv.ParseInt(js.Global().Get("String").Invoke(jsVal).String(), 10, 64)
+	if err != nil {
+		return 0, errArgType
+	}
+	return v, nil
+}
+
+// jsBigIntToUint64 converts JavaScript BigInt value to Go uint64, it returns
+// an error if the value is negative or overflows uint64.
+func jsBigIntToUint64(jsVal js.Value) (uint64, error) {
+	v, err := strconv.ParseUint(js.Global().Get("String").Invoke(jsVal).String(), 10, 64)
+	if err != nil {
+		return 0, errArgType
+	}
+	return v, nil
+}
+
+// jsBigIntToCellValue converts JavaScript BigInt value to Go int64, or uint64
+// if the value overflows int64, it returns nil if the value is out of the
+// range of both of them.
+func jsBigIntToCellValue(jsVal js.Value) interface{} {
+	if v, err := jsBigIntToInt64(jsVal); err == nil {
+		return v
+	}
+	if v, err := jsBigIntToUint64(jsVal); err == nil {
+		return v
 	}
 	return nil
 }
 
+// isJSDate checks if the given JavaScript value is a Date object.
+func isJSDate(jsVal js.Value) bool {
+	return jsType(jsVal) == js.TypeObject && jsVal.InstanceOf(js.Global().Get("Date"))
+}
+
+// jsDateToTime converts JavaScript Date object to Go time.Time by the local
+// date and time of the Date object, the returned time is in UTC location,
+// so the cell will store the same date and time as displayed in JavaScript.
+func jsDateToTime(jsVal js.Value) time.Time {
+	return time.Date(jsVal.Call("getFullYear").Int(), time.Month(jsVal.Call("getMonth").Int()+1),
+		jsVal.Call("getDate").Int(), jsVal.Call("getHours").Int(), jsVal.Call("getMinutes").Int(),
+		jsVal.Call("getSeconds").Int(), jsVal.Call("getMilliseconds").Int()*int(time.Millisecond), time.UTC)
+}
+
+// timeToJSDate converts Go time.Time to JavaScript Date object, the date and
+// time of the given time will be used as the local date and time of the Date
+// object, it is the reverse conversion of the jsDateToTime function.
+func timeToJSDate(t time.Time) js.Value {
+	return js.Global().Get("Date").New(t.Year(), int(t.Month())-1, t.Day(),
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond()/int(time.Millisecond))
+}
+
+// jsBytesToGo copies the given JavaScript binary data to the Go byte slice,
+// the Uint8Array, Node.js Buffer, other typed arrays, DataView and ArrayBuffer
+// are supported.
+func jsBytesToGo(jsVal js.Value) ([]byte, error) {
+	if jsType(jsVal) != js.TypeObject {
+		return nil, errArgType
+	}
+	global := js.Global()
+	if !jsVal.InstanceOf(global.Get("Uint8Array")) && !jsVal.InstanceOf(global.Get("Uint8ClampedArray")) {
+		switch {
+		case global.Get("ArrayBuffer").Call("isView", jsVal).Bool():
+			jsVal = global.Get("Uint8Array").New(jsVal.Get("buffer"), jsVal.Get("byteOffset"), jsVal.Get("byteLength"))
+		case jsVal.InstanceOf(global.Get("ArrayBuffer")):
+			jsVal = global.Get("Uint8Array").New(jsVal)
+		default:
+			return nil, errArgType
+		}
+	}
+	buf := make([]byte, jsVal.Length())
+	js.CopyBytesToGo(buf, jsVal)
+	return buf, nil
+}
+
+// isJSByteStream checks if the given JavaScript value is a Blob or a
+// ReadableStream, which can only be read asynchronously.
+func isJSByteStream(jsVal js.Value) bool {
+	if jsType(jsVal) != js.TypeObject {
+		return false
+	}
+	if blob := js.Global().Get("Blob"); jsType(blob) == js.TypeFunction && jsVal.InstanceOf(blob) {
+		return true
+	}
+	return jsType(jsVal.Get("getReader")) == js.TypeFunction
+}
+
+// jsStreamReader implements the io.Reader interface, which reads the chunks
+// of the JavaScript ReadableStream or Blob incrementally. It blocks until the
+// chunk is read, so it should be used in the goroutine started by newPromise.
+type jsStreamReader struct {
+	reader js.Value
+	chunk  []byte
+}
+
+// newJSStreamReader returns the reader of the given JavaScript ReadableStream
+// or Blob.
+func newJSStreamReader(jsVal js.Value) *jsStreamReader {
+	if jsType(jsVal.Get("getReader")) != js.TypeFunction {
+		jsVal = jsVal.Call("stream")
+	}
+	return &jsStreamReader{reader: jsVal.Call("getReader")}
+}
+
+// Read reads up to len(p) bytes from the stream, the next chunk will be read
+// from the stream after the current chunk consumed.
+func (r *jsStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		result, err := awaitJS(r.reader.Call("read"))
+		if err != nil {
+			return 0, err
+		}
+		if result.Get("done").Bool() {
+			return 0, io.EOF
+		}
+		if r.chunk, err = jsBytesToGo(result.Get("value")); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+// readJSBytes reads all bytes of the given JavaScript binary data, the Blob
+// and ReadableStream will be read by the jsStreamReader.
+func readJSBytes(jsVal js.Value) ([]byte, error) {
+	if isJSByteStream(jsVal) {
+		return io.ReadAll(newJSStreamReader(jsVal))
+	}
+	return jsBytesToGo(jsVal)
+}
+
+// awaitJS blocks until the given JavaScript promise settled, and returns the
+// fulfilled value or the rejection reason as an error. It should be called in
+// the goroutine started by newPromise, instead of the JavaScript callback.
+func awaitJS(promise js.Value) (js.Value, error) {
+	type settled struct {
+		value js.Value
+		err   error
+	}
+	ch := make(chan settled, 1)
+	onFulfilled := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		ch <- settled{value: args[0]}
+		return nil
+	})
+	defer onFulfilled.Release()
+	onRejected := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		ch <- settled{value: js.Undefined(), err: jsReasonToError(args[0])}
+		return nil
+	})
+	defer onRejected.Release()
+	promise.Call("then", onFulfilled, onRejected)
+	r := <-ch
+	return r.value, r.err
+}
+
+// jsReasonToError converts the JavaScript rejection reason or the thrown
+// exception to the Go error with the message of the reason.
+func jsReasonToError(reason js.Value) error {
+	if jsType(reason) == js.TypeObject && jsType(reason.Get("message")) == js.TypeString {
+		reason = reason.Get("message")
+	}
+	return errors.New(js.Global().Call("String", reason).String())
+}
+
+// invokeJS calls the given function which calls into JavaScript, and returns
+// the thrown JavaScript exception as an error instead of panic.
+func invokeJS(fn func() js.Value) (ret js.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			jsErr, ok := r.(js.Error)
+			if !ok {
+				panic(r)
+			}
+			ret, err = js.Undefined(), jsReasonToError(jsErr.Value)
+		}
+	}()
+	return fn(), nil
+}
+
+// newPromise returns a JavaScript promise fulfilled with the result of the
+// given function, which will be called in a new goroutine, so that it can
+// read the Blob and ReadableStream. The promise will be rejected with the
+// ExcelizeError instead if the result has an error in the exception mode.
+func newPromise(throw bool, fn func() interface{}) js.Value {
+	executor := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		resolve, reject := args[0], args[1]
+		go func() {
+			ret := fn()
+			if throw {
+				ret = throwable(func(this js.Value, args []js.Value) interface{} {
+					return ret
+				})(js.Undefined(), nil)
+				if v, ok := ret.(js.Value); ok && v.InstanceOf(excelizeError) {
+					reject.Invoke(v)
+					return
+				}
+			}
+			resolve.Invoke(ret)
+		}()
+		return nil
+	})
+	defer executor.Release()
+	return js.Global().Get("Promise").New(executor)
+}
+
+// splitByteStreamField returns a copy of the given options object without the
+// field if the value of the field is a Blob or ReadableStream, and returns the
+// value of the field, which should be read by the readJSBytes in the promise.
+func splitByteStreamField(opts js.Value, field string) (js.Value, js.Value) {
+	if jsType(opts) != js.TypeObject || !isJSByteStream(opts.Get(field)) {
+		return opts, js.Undefined()
+	}
+	src := opts.Get(field)
+	opts = js.Global().Get("Object").Call("assign", js.ValueOf(map[string]interface{}{}), opts)
+	opts.Delete(field)
+	return opts, src
+}
+
+// isDate1904 checks if the workbook uses the 1904 date system.
+func isDate1904(f *excelize.File) (bool, error) {
+	props, err := f.GetWorkbookProps()
+	if err != nil {
+		return false, err
+	}
+	return props.Date1904 != nil && *props.Date1904, nil
+}
+
+// excelDateToTime converts a float-based Excel date representation to Go
+// time.Time according to the date system of the workbook.
+func excelDateToTime(f *excelize.File, excelDate float64) (time.Time, error) {
+	date1904, err := isDate1904(f)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return excelize.ExcelDateToTime(excelDate, date1904)
+}
+
+// jsValueToCellValue converts JavaScript boolean, number, BigInt, string and
+// Date value to the Go data type which could be used as a cell value, other
+// data types will be converted to nil.
+func jsValueToCellValue(jsVal js.Value) interface{} {
+	switch jsType(jsVal) {
+	case js.TypeBoolean:
+		return jsVal.Bool()
+	case js.TypeNumber:
+		return jsVal.Float()
+	case jsTypeBigInt:
+		return jsBigIntToCellValue(jsVal)
+	case js.TypeString:
+		return jsVal.String()
+	default:
+		if isJSDate(jsVal) {
+			return jsDateToTime(jsVal)
+		}
+		return nil
+	}
+}
+
+// typedCellReader provides a function to get the typed cell value of a
+// worksheet, it caches the date and time number format checking result of
+// each style.
+type typedCellReader struct {
+	f          *excelize.File
+	sheet      string
+	bigInt     bool
+	date1904   bool
+	dateStyles map[int]bool
+}
+
+// newTypedCellReader returns a typed cell reader by given worksheet name, the
+// bigInt parameter specifies whether to get the integers out of the safe
+// integer range as BigInt.
+func newTypedCellReader(f *excelize.File, sheet string, bigInt bool) (*typedCellReader, error) {
+	date1904, err := isDate1904(f)
+	if err != nil {
+		return nil, err
+	}
+	return &typedCellReader{f: f, sheet: sheet, bigInt: bigInt, date1904: date1904, dateStyles: map[int]bool{}}, nil
+}
+
+// isDateStyle checks if the number format of the given cell is a date and
+// time format.
+func (r *typedCellReader) isDateStyle(cell string) (bool, error) {
+	styleID, err := r.f.GetCellStyle(r.sheet, cell)
+	if err != nil || styleID == 0 {
+		return false, err
+	}
+	if isDate, ok := r.dateStyles[styleID]; ok {
+		return isDate, nil
+	}
+	style, err := r.f.GetStyle(styleID)
+	if err != nil {
+		return false, err
+	}
+	isDate := isDateNumFmt(style.NumFmt)
+	if style.CustomNumFmt != nil {
+		isDate = isDateCustomNumFmt(*style.CustomNumFmt)
+	}
+	r.dateStyles[styleID] = isDate
+	return isDate, nil
+}
+
+// value returns the typed value of the cell by given cell reference and the
+// raw cell value. The empty cell will be null, number and boolean cells will
+// be converted to JavaScript number and boolean, number cells with date and
+// time number format and date cells will be converted to JavaScript Date
+// object, and error cells will be converted to an object with the Error
+// field, for example: { Error: '#DIV/0!' }. The integer number cells out of
+// the safe integer range will be converted to JavaScript BigInt if the bigInt
+// field of the reader is true.
+func (r *typedCellReader) value(cell, raw string) (interface{}, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	cellType, err := r.f.GetCellType(r.sheet, cell)
+	if err != nil {
+		return nil, err
+	}
+	switch cellType {
+	case excelize.CellTypeBool:
+		return raw == "1" || strings.EqualFold(raw, "TRUE"), nil
+	case excelize.CellTypeDate:
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999", "2006-01-02"} {
+			if t, err := time.Parse(layout, raw); err == nil {
+				return timeToJSDate(t), nil
+			}
+		}
+		return raw, nil
+	case excelize.CellTypeError:
+		return map[string]interface{}{"Error": raw}, nil
+	case excelize.CellTypeUnset, excelize.CellTypeNumber:
+		if r.bigInt {
+			if v, ok := bigIntCellValue(raw); ok {
+				return v, nil
+			}
+		}
+		num, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return raw, nil
+		}
+		isDate, err := r.isDateStyle(cell)
+		if err != nil || !isDate {
+			return num, err
+		}
+		t, err := excelize.ExcelDateToTime(num, r.date1904)
+		if err != nil {
+			return num, nil
+		}
+		return timeToJSDate(t), nil
+	default:
+		return raw, nil
+	}
+}
+
+// bigIntCellValue returns the JavaScript BigInt value of the given raw cell
+// value if it is an integer out of the safe integer range.
+func bigIntCellValue(raw string) (js.Value, bool) {
+	if v, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		if v < -maxSafeInteger || v > maxSafeInteger {
+			return js.Global().Get("BigInt").Invoke(raw), true
+		}
+		return js.Undefined(), false
+	}
+	if _, err := strconv.ParseUint(raw, 10, 64); err == nil {
+		return js.Global().Get("BigInt").Invoke(raw), true
+	}
+	return js.Undefined(), false
+}
+
+// matrixToJS converts the cell values matrix of the worksheet which returned
+// by the GetRows or GetCols function to JavaScript array, the byCol parameter
+// specifies whether the matrix is ordered by columns. The raw cell values will
+// be converted to typed values if the typed parameter is true.
+func matrixToJS(f *excelize.File, sheet string, matrix [][]string, typed, bigInt, byCol bool) ([]interface{}, error) {
+	var reader *typedCellReader
+	if typed {
+		var err error
+		if reader, err = newTypedCellReader(f, sheet, bigInt); err != nil {
+			return nil, err
+		}
+	}
+	result := make([]interface{}, len(matrix))
+	for i, values := range matrix {
+		line := make([]interface{}, len(values))
+		for j, value := range values {
+			if !typed {
+				line[j] = value
+				continue
+			}
+			col, row := j+1, i+1
+			if byCol {
+				col, row = i+1, j+1
+			}
+			cell, err := excelize.CoordinatesToCellName(col, row)
+			if err != nil {
+				return nil, err
+			}
+			if line[j], err = reader.value(cell, value); err != nil {
+				return nil, err
+			}
+		}
+		result[i] = js.ValueOf(line)
+	}
+	return result, nil
+}
+
+// isDateNumFmt checks if the given built-in number format ID is a date and
+// time number format.
+func isDateNumFmt(numFmt int) bool {
+	return (14 <= numFmt && numFmt <= 22) || (27 <= numFmt && numFmt <= 36) ||
+		(45 <= numFmt && numFmt <= 47) || (50 <= numFmt && numFmt <= 58) ||
+		(71 <= numFmt && numFmt <= 81)
+}
+
+// isDateCustomNumFmt checks if the given custom number format code is a date
+// and time number format, the literal strings, escaped characters and the
+// color or condition sections in the format code will be ignored.
+func isDateCustomNumFmt(numFmt string) bool {
+	for i := 0; i < len(numFmt); i++ {
+		switch c := numFmt[i]; c {
+		case '"':
+			if end := strings.IndexByte(numFmt[i+1:], '"'); end != -1 {
+				i += end + 1
+				continue
+			}
+			return false
+		case '[':
+			end := strings.IndexByte(numFmt[i+1:], ']')
+			if end == -1 {
+				return false
+			}
+			// Elapsed time format, for example: [h]:mm:ss
+			if section := strings.ToLower(numFmt[i+1 : i+1+end]); section != "" &&
+				strings.Trim(section, "hms") == "" {
+				return true
+			}
+			i += end + 1
+		case '\\', '_', '*':
+			i++
+		default:
+			if strings.ContainsRune("yYmMdDhHsS", rune(c)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// typedOption returns the value of the Typed and BigInt fields in the given
+// options.
+func typedOption(opts js.Value) (typed, bigInt bool, err error) {
+	for _, opt := range []struct {
+		name  string
+		value *bool
+	}{{"Typed", &typed}, {"BigInt", &bigInt}} {
+		switch v := opts.Get(opt.name); jsType(v) {
+		case js.TypeUndefined:
+		case js.TypeBoolean:
+			*opt.value = v.Bool()
+		default:
+			return false, false, errArgType
+		}
+	}
+	return typed, bigInt, nil
+}
+
 // CellNameToCoordinates converts alphanumeric cell name to [X, Y] coordinates
 // or returns an error.
 func CellNameToCoordinates(this js.Value, args []js.Value) interface{} {
 	ret := map[string]interface{}{"col": 0, "row": 0, "error": nil}
 	if err := prepareArgs(args, []argsRule{{types: []js.Type{js.TypeString}}}); err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	col, row, err := excelize.CellNameToCoordinates(args[0].String())
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	ret["col"], ret["row"] = col, row
@@ -861,12 +2438,12 @@ func ColumnNameToNumber(this js.Value, args []js.Value) interface{} {
 	ret := map[string]interface{}{"col": 0, "error": nil}
 	err := prepareArgs(args, []argsRule{{types: []js.Type{js.TypeString}}})
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	ret["col"], err = excelize.ColumnNameToNumber(args[0].String())
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 	}
 	return js.ValueOf(ret)
 }
@@ -877,12 +2454,12 @@ func ColumnNumberToName(this js.Value, args []js.Value) interface{} {
 	ret := map[string]interface{}{"col": 0, "error": nil}
 	err := prepareArgs(args, []argsRule{{types: []js.Type{js.TypeNumber}}})
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	ret["col"], err = excelize.ColumnNumberToName(args[0].Int())
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 	}
 	return js.ValueOf(ret)
 }
@@ -897,7 +2474,7 @@ func CoordinatesToCellName(this js.Value, args []js.Value) interface{} {
 		{types: []js.Type{js.TypeBoolean}, opts: true},
 	})
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	var abs bool
@@ -906,7 +2483,7 @@ func CoordinatesToCellName(this js.Value, args []js.Value) interface{} {
 	}
 	ret["cell"], err = excelize.CoordinatesToCellName(args[0].Int(), args[1].Int(), abs)
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 	}
 	return js.ValueOf(ret)
 }
@@ -919,7 +2496,7 @@ func HSLToRGB(this js.Value, args []js.Value) interface{} {
 		{types: []js.Type{js.TypeNumber}},
 		{types: []js.Type{js.TypeNumber}},
 	}); err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	ret["r"], ret["g"], ret["b"] = excelize.HSLToRGB(args[0].Float(), args[1].Float(), args[2].Float())
@@ -934,12 +2511,12 @@ func JoinCellName(this js.Value, args []js.Value) interface{} {
 		{types: []js.Type{js.TypeNumber}},
 	})
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	ret["cell"], err = excelize.JoinCellName(args[0].String(), args[1].Int())
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 	}
 	return js.ValueOf(ret)
 }
@@ -952,7 +2529,7 @@ func RGBToHSL(this js.Value, args []js.Value) interface{} {
 		{types: []js.Type{js.TypeNumber}},
 		{types: []js.Type{js.TypeNumber}},
 	}); err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	ret["h"], ret["s"], ret["l"] = excelize.RGBToHSL(uint8(args[0].Int()), uint8(args[1].Int()), uint8(args[2].Int()))
@@ -964,12 +2541,12 @@ func SplitCellName(this js.Value, args []js.Value) interface{} {
 	ret := map[string]interface{}{"col": "", "row": 0, "error": nil}
 	err := prepareArgs(args, []argsRule{{types: []js.Type{js.TypeString}}})
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	ret["col"], ret["row"], err = excelize.SplitCellName(args[0].String())
 	if err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 	}
 	return js.ValueOf(ret)
 }
@@ -981,7 +2558,7 @@ func ThemeColor(this js.Value, args []js.Value) interface{} {
 		{types: []js.Type{js.TypeString}},
 		{types: []js.Type{js.TypeNumber}},
 	}); err != nil {
-		ret["error"] = err.Error()
+		setError(ret, args, err)
 		return js.ValueOf(ret)
 	}
 	ret["color"] = excelize.ThemeColor(args[0].String(), args[1].Float())
@@ -995,22 +2572,37 @@ func NewFile(this js.Value, args []js.Value) interface{} {
 	if err := prepareArgs(args, []argsRule{
 		{types: []js.Type{js.TypeObject}, opts: true},
 	}); err != nil {
-		fn["error"] = err.Error()
+		setError(fn, args, err)
 		return js.ValueOf(fn)
 	}
 	if len(args) == 1 {
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Options{}))
+		throw, strictOpts, bigInt, err := fileModeOptions(args[0])
 		if err != nil {
-			fn["error"] = err.Error()
+			setError(fn, args, err)
 			return js.ValueOf(fn)
 		}
-		return regInteropFunc(excelize.NewFile(goVal.Elem().Interface().(excelize.Options)), fn)
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Options{}), strictOpts, "ThrowOnError", "Strict", "BigInt")
+		if err != nil {
+			setError(fn, args, err)
+			return js.ValueOf(fn)
+		}
+		return regInteropFunc(excelize.NewFile(goVal.Elem().Interface().(excelize.Options)), fn, throw, strictOpts, bigInt)
 	}
-	return regInteropFunc(excelize.NewFile(), fn)
+	return regInteropFunc(excelize.NewFile(), fn, throwOnError, strictMode, false)
 }
 
 // OpenReader read data stream from buffer and return a populated spreadsheet
-// file.
+// file. The Uint8Array, Node.js Buffer, DataView and ArrayBuffer are read
+// synchronously, and the Blob and ReadableStream are read incrementally, a
+// promise of the spreadsheet file will be returned for them. The progress of
+// reading the stream and unzipping will be reported to the OnProgress
+// callback function, and the workbook will be closed if the Signal aborted.
+// The shared strings table and worksheets are parsed on their first access,
+// so their progress can't be reported. The CharsetReader option accepts the
+// same transcoder as the CharsetTranscoder function, which will be applied
+// before parsing the workbook, so the parts parsed on opening, such as the
+// workbook, styles and theme parts, will be decoded by it as well, except for
+// the encrypted workbook.
 func OpenReader(this js.Value, args []js.Value) interface{} {
 	fn := map[string]interface{}{"error": nil}
 	fn["error"] = nil
@@ -1019,30 +2611,220 @@ func OpenReader(this js.Value, args []js.Value) interface{} {
 		{types: []js.Type{js.TypeObject}, opts: true},
 	})
 	if err != nil {
-		fn["error"] = err.Error()
-		return js.ValueOf(fn)
-	}
-	if args[0].Length() == 0 {
-		fn["error"] = excelize.ErrParameterInvalid.Error()
+		setError(fn, args, err)
 		return js.ValueOf(fn)
 	}
-	buf := make([]byte, args[0].Get("length").Int())
-	js.CopyBytesToGo(buf, args[0])
-	var opts excelize.Options
+	var (
+		opts       excelize.Options
+		p          *progressTracker
+		transcoder = js.Undefined()
+	)
+	throw, strictOpts, bigInt := throwOnError, strictMode, false
 	if len(args) == 2 {
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Options{}))
+		if throw, strictOpts, bigInt, err = fileModeOptions(args[1]); err != nil {
+			setError(fn, args, err)
+			return js.ValueOf(fn)
+		}
+		if p, err = progressOptions(args[1]); err != nil {
+			setError(fn, args, err)
+			return js.ValueOf(fn)
+		}
+		if transcoder = args[1].Get("CharsetReader"); !transcoder.IsUndefined() {
+			if _, err = jsCharsetReader(transcoder, nil); err != nil {
+				setError(fn, args, &argError{err: err, index: -1, path: "CharsetReader", value: args[1]})
+				return js.ValueOf(fn)
+			}
+		}
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Options{}), strictOpts, "ThrowOnError", "Strict", "BigInt", "OnProgress", "Signal", "CharsetReader")
 		if err != nil {
-			fn["error"] = err.Error()
+			setError(fn, args, err)
 			return js.ValueOf(fn)
 		}
 		opts = goVal.Elem().Interface().(excelize.Options)
 	}
-	f, err := excelize.OpenReader(bytes.NewReader(buf), opts)
+	if isJSByteStream(args[0]) {
+		r := &progressReader{r: newJSStreamReader(args[0]), p: p, phase: "read"}
+		if args[0].InstanceOf(js.Global().Get("Blob")) {
+			r.total = args[0].Get("size").Int()
+		}
+		return newPromise(throw, func() interface{} {
+			buf, err := io.ReadAll(r)
+			if err != nil {
+				if err == errCancelled {
+					r.r.(*jsStreamReader).reader.Call("cancel")
+				}
+				setError(fn, args, err)
+				return js.ValueOf(fn)
+			}
+			return openReader(buf, fn, args, opts, p, transcoder, throw, strictOpts, bigInt)
+		})
+	}
+	buf, err := jsBytesToGo(args[0])
+	if err != nil || len(buf) == 0 {
+		setError(fn, args, excelize.ErrParameterInvalid)
+		return js.ValueOf(fn)
+	}
+	return openReader(buf, fn, args, opts, p, transcoder, throw, strictOpts, bigInt)
+}
+
+// openReader opens the spreadsheet from the given buffer, and registers the
+// functions of the workbook on the given object with the given error handling
+// mode, option validation mode and BigInt mode.
+func openReader(buf []byte, fn map[string]interface{}, args []js.Value, opts excelize.Options, p *progressTracker, transcoder js.Value, throw, strict, bigInt bool) interface{} {
+	f, err := openFile(buf, opts, p, transcoder)
 	if err != nil {
-		fn["error"] = err.Error()
+		setError(fn, args, err)
 		return js.ValueOf(fn)
 	}
-	return regInteropFunc(f, fn)
+	return regInteropFunc(f, fn, throw, strict, bigInt)
+}
+
+// openFile opens the spreadsheet from the given buffer, and sets the charset
+// transcoder if it's not undefined, the XML parts declared with non UTF-8
+// encoding will be decoded by the transcoder before opening. The progress of
+// unzipping will be reported if the progress tracker specified, and the
+// workbook will be closed on cancellation.
+func openFile(buf []byte, opts excelize.Options, p *progressTracker, transcoder js.Value) (*excelize.File, error) {
+	var parts int
+	if p != nil {
+		if zr, err := zip.NewReader(bytes.NewReader(buf), int64(len(buf))); err == nil {
+			parts = len(zr.File)
+		}
+		if err := p.report("unzip", 0, parts); err != nil {
+			return nil, err
+		}
+	}
+	if !transcoder.IsUndefined() {
+		fn, _ := jsCharsetReader(transcoder, labelReader)
+		buf = transcodeParts(buf, fn)
+	}
+	f, err := excelize.OpenReader(bytes.NewReader(buf), opts)
+	if err != nil {
+		return nil, err
+	}
+	if !transcoder.IsUndefined() {
+		fn, _ := jsCharsetReader(transcoder, f.CharsetReader)
+		f.CharsetTranscoder(fn)
+	}
+	if p == nil {
+		return f, nil
+	}
+	if err = p.report("unzip", parts, parts); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// xmlEncodingExp defined the expression of the encoding declaration in the
+// XML declaration at the beginning of the XML part.
+var xmlEncodingExp = regexp.MustCompile(`^(\s*<\?xml[^>]*?\sencoding\s*=\s*)(?:"([^"]*)"|'([^']*)')`)
+
+// transcodeParts returns the zip archive of the workbook in which the XML
+// parts declared with non UTF-8 encoding are decoded by the given charset
+// reader and declared as UTF-8. The given buffer will be returned if it's not
+// a zip archive or there are no such parts, and the parts which failed to
+// decode are left as is, the errors of them will be returned by the functions
+// which access them later.
+func transcodeParts(buf []byte, reader func(charset string, input io.Reader) (io.Reader, error)) []byte {
+	zr, err := zip.NewReader(bytes.NewReader(buf), int64(len(buf)))
+	if err != nil {
+		return buf
+	}
+	parts := map[*zip.File][]byte{}
+	for _, file := range zr.File {
+		rc, err := file.Open()
+		if err != nil {
+			continue
+		}
+		content, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			continue
+		}
+		loc := xmlEncodingExp.FindSubmatchIndex(content)
+		if loc == nil {
+			continue
+		}
+		start, end := loc[4], loc[5]
+		if start < 0 {
+			start, end = loc[6], loc[7]
+		}
+		label := string(content[start:end])
+		if strings.EqualFold(label, "utf-8") || strings.EqualFold(label, "utf8") {
+			continue
+		}
+		r, err := reader(label, bytes.NewReader(content[loc[1]:]))
+		if err != nil {
+			continue
+		}
+		decoded, err := io.ReadAll(r)
+		if err != nil {
+			continue
+		}
+		parts[file] = append(append(append([]byte{}, content[:loc[3]]...), `"UTF-8"`...), decoded...)
+	}
+	if len(parts) == 0 {
+		return buf
+	}
+	var out bytes.Buffer
+	zw := zip.NewWriter(&out)
+	for _, file := range zr.File {
+		content, ok := parts[file]
+		if !ok {
+			if err = zw.Copy(file); err != nil {
+				return buf
+			}
+			continue
+		}
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: file.Name, Method: zip.Deflate, Modified: file.Modified})
+		if err != nil {
+			return buf
+		}
+		if _, err = w.Write(content); err != nil {
+			return buf
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return buf
+	}
+	return out.Bytes()
+}
+
+// SetThrowOnError provides a function to set the global error handling mode,
+// the functions will throw the ExcelizeError instead of returning the result
+// object with the error field, and return the payload of the result object
+// directly in the exception mode. This mode will be applied on the exported
+// functions and the workbooks opened later without the ThrowOnError option.
+func SetThrowOnError(this js.Value, args []js.Value) interface{} {
+	ret := map[string]interface{}{"error": nil}
+	if err := prepareArgs(args, []argsRule{
+		{types: []js.Type{js.TypeBoolean}},
+	}); err != nil {
+		setError(ret, args, err)
+		return js.ValueOf(ret)
+	}
+	throwOnError = args[0].Bool()
+	exportGlobalFuncs()
+	return js.ValueOf(ret)
+}
+
+// SetStrictMode provides a function to set the global option validation mode,
+// the unknown option fields, the invalid enumeration values and the out of
+// range numbers of the options will be reported as errors with the path of
+// the field before calling the excelize in the strict mode, for example:
+// Series[2].Marker.Symbol. This mode will be applied on the exported
+// functions and the workbooks opened later without the Strict option.
+func SetStrictMode(this js.Value, args []js.Value) interface{} {
+	ret := map[string]interface{}{"error": nil}
+	if err := prepareArgs(args, []argsRule{
+		{types: []js.Type{js.TypeBoolean}},
+	}); err != nil {
+		setError(ret, args, err)
+		return js.ValueOf(ret)
+	}
+	strictMode = args[0].Bool()
+	return js.ValueOf(ret)
 }
 
 // AddChart provides the method to add chart in a sheet by given chart format
@@ -1058,38 +2840,38 @@ func AddChart(f *excelize.File) func(this js.Value, args []js.Value) interface{}
 			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var chart, combo excelize.Chart
-		chartVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Chart{}))
+		chartVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Chart{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if args[2].Get("Type").IsUndefined() {
-			ret["error"] = errArgType.Error()
+			setError(ret, args, errArgType)
 			return js.ValueOf(ret)
 		}
 		chart = chartVal.Elem().Interface().(excelize.Chart)
 		if len(args) == 4 {
-			comboVal, err := jsValueToGo(args[3], reflect.TypeOf(excelize.Chart{}))
+			comboVal, err := jsValueToGo(args[3], reflect.TypeOf(excelize.Chart{}), fileStates[f].strict)
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			if args[3].Get("Type").IsUndefined() {
-				ret["error"] = errArgType.Error()
+				setError(ret, args, errArgType)
 				return js.ValueOf(ret)
 			}
 			combo = comboVal.Elem().Interface().(excelize.Chart)
 			if err = f.AddChart(args[0].String(), args[1].String(), &chart, &combo); err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 			}
 			return js.ValueOf(ret)
 		}
 		if err = f.AddChart(args[0].String(), args[1].String(), &chart); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1108,30 +2890,30 @@ func AddChartSheet(f *excelize.File) func(this js.Value, args []js.Value) interf
 			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var chart, combo excelize.Chart
-		chartVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Chart{}))
+		chartVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Chart{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		chart = chartVal.Elem().Interface().(excelize.Chart)
 		if len(args) == 3 {
-			comboVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Chart{}))
+			comboVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Chart{}), fileStates[f].strict)
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			combo = comboVal.Elem().Interface().(excelize.Chart)
 			if err = f.AddChartSheet(args[0].String(), &chart, &combo); err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 			}
 			return js.ValueOf(ret)
 		}
 		if err = f.AddChartSheet(args[0].String(), &chart); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1147,18 +2929,18 @@ func AddComment(f *excelize.File) func(this js.Value, args []js.Value) interface
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var opt excelize.Comment
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Comment{}))
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Comment{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opt = goVal.Elem().Interface().(excelize.Comment)
 		if err := f.AddComment(args[0].String(), opt); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1173,18 +2955,18 @@ func AddDataValidation(f *excelize.File) func(this js.Value, args []js.Value) in
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var dv excelize.DataValidation
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.DataValidation{}))
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.DataValidation{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		dv = goVal.Elem().Interface().(excelize.DataValidation)
 		if err := f.AddDataValidation(args[0].String(), &dv); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1202,18 +2984,18 @@ func AddFormControl(f *excelize.File) func(this js.Value, args []js.Value) inter
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var opts excelize.FormControl
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.FormControl{}))
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.FormControl{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts = goVal.Elem().Interface().(excelize.FormControl)
 		if err := f.AddFormControl(args[0].String(), opts); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1232,20 +3014,33 @@ func AddHeaderFooterImage(f *excelize.File) func(this js.Value, args []js.Value)
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var opts excelize.HeaderFooterImageOptions
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.HeaderFooterImageOptions{}))
+		jsOpts, src := splitByteStreamField(args[1], "File")
+		goVal, err := jsValueToGo(jsOpts, reflect.TypeOf(excelize.HeaderFooterImageOptions{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, []js.Value{args[0], jsOpts}, err)
 			return js.ValueOf(ret)
 		}
 		opts = goVal.Elem().Interface().(excelize.HeaderFooterImageOptions)
-		if err := f.AddHeaderFooterImage(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
+		addImage := func() interface{} {
+			if err := f.AddHeaderFooterImage(args[0].String(), &opts); err != nil {
+				setError(ret, args, err)
+			}
+			return js.ValueOf(ret)
 		}
-		return js.ValueOf(ret)
+		if !src.IsUndefined() {
+			return newPromise(fileStates[f].throwOnError, func() interface{} {
+				if opts.File, err = readJSBytes(src); err != nil {
+					setError(ret, args, &argError{err: err, index: 1, path: "File"})
+					return js.ValueOf(ret)
+				}
+				return addImage()
+			})
+		}
+		return addImage()
 	}
 }
 
@@ -1258,11 +3053,11 @@ func AddIgnoredErrors(f *excelize.File) func(this js.Value, args []js.Value) int
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeNumber}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if err := f.AddIgnoredErrors(args[0].String(), args[1].String(), excelize.IgnoredErrorsType(args[2].Int())); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1279,20 +3074,33 @@ func AddPictureFromBytes(f *excelize.File) func(this js.Value, args []js.Value)
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var pic excelize.Picture
-		goVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Picture{}))
+		opts, src := splitByteStreamField(args[2], "File")
+		goVal, err := jsValueToGo(opts, reflect.TypeOf(excelize.Picture{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, []js.Value{args[0], args[1], opts}, err)
 			return js.ValueOf(ret)
 		}
 		pic = goVal.Elem().Interface().(excelize.Picture)
-		if err := f.AddPictureFromBytes(args[0].String(), args[1].String(), &pic); err != nil {
-			ret["error"] = err.Error()
+		addPicture := func() interface{} {
+			if err := f.AddPictureFromBytes(args[0].String(), args[1].String(), &pic); err != nil {
+				setError(ret, args, err)
+			}
+			return js.ValueOf(ret)
 		}
-		return js.ValueOf(ret)
+		if !src.IsUndefined() {
+			return newPromise(fileStates[f].throwOnError, func() interface{} {
+				if pic.File, err = readJSBytes(src); err != nil {
+					setError(ret, args, &argError{err: err, index: 2, path: "File"})
+					return js.ValueOf(ret)
+				}
+				return addPicture()
+			})
+		}
+		return addPicture()
 	}
 }
 
@@ -1305,45 +3113,18 @@ func AddPivotTable(f *excelize.File) func(this js.Value, args []js.Value) interf
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var opts excelize.PivotTableOptions
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.PivotTableOptions{}))
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.PivotTableOptions{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts = goVal.Elem().Interface().(excelize.PivotTableOptions)
 		if err := f.AddPivotTable(&opts); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// AddShape provides the method to add shape in a sheet by given worksheet
-// index, shape format set (such as offset, scale, aspect ratio setting and
-// print settings) and properties set.
-func AddShape(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.Shape
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Shape{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		opts = goVal.Elem().Interface().(excelize.Shape)
-		if err := f.AddShape(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1358,73 +3139,18 @@ func AddSlicer(f *excelize.File) func(this js.Value, args []js.Value) interface{
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var opts excelize.SlicerOptions
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.SlicerOptions{}))
+		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.SlicerOptions{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts = goVal.Elem().Interface().(excelize.SlicerOptions)
 		if err := f.AddSlicer(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// AddSparkline provides a function to add sparklines to the worksheet by
-// given formatting options. Sparklines are small charts that fit in a single
-// cell and are used to show trends in data. Sparklines are a feature of Excel
-// 2010 and later only. You can write them to an XLSX file that can be read by
-// Excel 2007, but they won't be displayed.
-func AddSparkline(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.SparklineOptions
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.SparklineOptions{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		opts = goVal.Elem().Interface().(excelize.SparklineOptions)
-		if err := f.AddSparkline(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// AddTable provides the method to add table in a worksheet by given worksheet
-// name, range reference and format set.
-func AddTable(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.Table
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Table{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		opts = goVal.Elem().Interface().(excelize.Table)
-		if err := f.AddTable(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1438,15 +3164,24 @@ func AddVBAProject(f *excelize.File) func(this js.Value, args []js.Value) interf
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		buf := make([]byte, args[0].Get("length").Int())
-		js.CopyBytesToGo(buf, args[0])
-		if err := f.AddVBAProject(buf); err != nil {
-			ret["error"] = err.Error()
+		addVBAProject := func(buf []byte, err error) interface{} {
+			if err == nil {
+				err = f.AddVBAProject(buf)
+			}
+			if err != nil {
+				setError(ret, args, err)
+			}
+			return js.ValueOf(ret)
 		}
-		return js.ValueOf(ret)
+		if isJSByteStream(args[0]) {
+			return newPromise(fileStates[f].throwOnError, func() interface{} {
+				return addVBAProject(readJSBytes(args[0]))
+			})
+		}
+		return addVBAProject(jsBytesToGo(args[0]))
 	}
 }
 
@@ -1461,20 +3196,20 @@ func AutoFilter(f *excelize.File) func(this js.Value, args []js.Value) interface
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var opts []excelize.AutoFilterOptions
 		for i := 0; i < args[2].Length(); i++ {
-			goVal, err := jsValueToGo(args[2].Index(i), reflect.TypeOf(excelize.AutoFilterOptions{}))
+			goVal, err := jsValueToGo(args[2].Index(i), reflect.TypeOf(excelize.AutoFilterOptions{}), fileStates[f].strict)
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			opts = append(opts, goVal.Elem().Interface().(excelize.AutoFilterOptions))
 		}
 		if err := f.AutoFilter(args[0].String(), args[1].String(), opts); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1483,7 +3218,10 @@ func AutoFilter(f *excelize.File) func(this js.Value, args []js.Value) interface
 // CalcCellValue provides a function to get calculated cell value. This feature
 // is currently in working processing. Iterative calculation, implicit
 // intersection, explicit intersection, array formula, table formula and some
-// other formulas are not supported currently.
+// other formulas are not supported currently. The start and the end of the
+// calculation will be reported to the OnProgress callback function, and the
+// calculation will not be started if the Signal aborted, but it can't be
+// interrupted after started.
 func CalcCellValue(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"value": "", "error": nil}
@@ -1493,79 +3231,234 @@ func CalcCellValue(f *excelize.File) func(this js.Value, args []js.Value) interf
 			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var opts excelize.Options
+		var (
+			opts excelize.Options
+			p    *progressTracker
+		)
 		if len(args) == 3 {
-			goVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Options{}))
+			if p, err = progressOptions(args[2]); err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			goVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Options{}), fileStates[f].strict, "OnProgress", "Signal")
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			opts = goVal.Elem().Interface().(excelize.Options)
 		}
-		if ret["value"], err = f.CalcCellValue(args[0].String(), args[1].String(), opts); err != nil {
-			ret["error"] = err.Error()
+		if err = p.report("calc", 0, 1); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["value"], err = f.CalcCellValue(args[0].String(), args[1].String(), opts); err == nil {
+			err = p.report("calc", 1, 1)
+		}
+		if err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// CopySheet provides a function to duplicate a worksheet by gave source and
-// target worksheet index. Note that currently doesn't support duplicate
-// workbooks that contain tables, charts or pictures.
-func CopySheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// CharsetTranscoder set user defined codepage transcoder function for open
+// workbook from non UTF-8 encoding. The transcoder could be a JavaScript
+// function, which accepts the charset label declared by the XML part and the
+// bytes of the part, and returns the decoded string, or returns undefined to
+// decode by the built-in decoder of the label. It could also be the name of
+// the built-in encoding, such as "windows-1251" or "shift_jis", which will be
+// used to decode the non UTF-8 parts declared with the labels not supported
+// by the built-in encodings, the other parts will be decoded by their labels.
+func CharsetTranscoder(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
 		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeFunction, js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.CopySheet(args[0].Int(), args[1].Int()); err != nil {
-			ret["error"] = err.Error()
+		fn, err := jsCharsetReader(args[0], f.CharsetReader)
+		if err != nil {
+			setError(ret, args, &argError{err: err, index: 0})
+			return js.ValueOf(ret)
 		}
+		f.CharsetTranscoder(fn)
 		return js.ValueOf(ret)
 	}
 }
 
-// DeleteChart provides a function to delete chart in spreadsheet by given
-// worksheet name and cell reference.
-func DeleteChart(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// jsCharsetReader returns the charset reader function by given JavaScript
+// transcoder function or encoding name, the given default charset reader will
+// be used if the transcoder function returns undefined or null. The encoding
+// name will be used for the charset labels which not supported by the
+// built-in encodings.
+func jsCharsetReader(transcoder js.Value, defaultReader func(charset string, input io.Reader) (io.Reader, error)) (func(charset string, input io.Reader) (io.Reader, error), error) {
+	if jsType(transcoder) == js.TypeString {
+		enc, err := lookupEncoding(transcoder.String())
+		if err != nil {
+			return nil, err
+		}
+		return func(charset string, input io.Reader) (io.Reader, error) {
+			if r, err := labelReader(charset, input); err == nil {
+				return r, nil
+			}
+			return enc.NewDecoder().Reader(input), nil
+		}, nil
+	}
+	if jsType(transcoder) != js.TypeFunction {
+		return nil, errArgType
+	}
+	return func(charset string, input io.Reader) (io.Reader, error) {
+		buf, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		data := js.Global().Get("Uint8Array").New(len(buf))
+		js.CopyBytesToJS(data, buf)
+		result, err := invokeJS(func() js.Value { return transcoder.Invoke(charset, data) })
+		if err != nil {
+			return nil, err
+		}
+		switch jsType(result) {
+		case js.TypeString:
+			return strings.NewReader(result.String()), nil
+		case js.TypeUndefined, js.TypeNull:
+			return defaultReader(charset, bytes.NewReader(buf))
+		default:
+			return nil, errArgType
+		}
+	}, nil
+}
+
+// labelReader returns the reader which decodes the given input by the built-in
+// encoding of the given charset label.
+func labelReader(charset string, input io.Reader) (io.Reader, error) {
+	enc, err := lookupEncoding(charset)
+	if err != nil {
+		return nil, errors.New("unsupported charset: " + strconv.Quote(charset))
+	}
+	return enc.NewDecoder().Reader(input), nil
+}
+
+// lookupEncoding returns the built-in encoding by given name, the WHATWG
+// encoding labels and the IANA character set names are supported.
+func lookupEncoding(name string) (encoding.Encoding, error) {
+	if enc, err := htmlindex.Get(name); err == nil {
+		return enc, nil
+	}
+	if enc, err := ianaindex.IANA.Encoding(name); err == nil && enc != nil {
+		return enc, nil
+	}
+	return nil, errArgValue
+}
+
+// Close closes and cleanup the open temporary file for the spreadsheet, and
+// releases all the JavaScript functions registered for the workbook and the
+// objects derived from it, such as merged cells, stream writer and iterators.
+// After the workbook closed, all the functions of these objects will return
+// the file closed error.
+func Close(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		err := f.Close()
+		releaseFile(f)
+		if err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// Cols returns a columns iterator, used for streaming reading data for a
+// worksheet with a large data. This function is concurrency safe. The
+// optional options will be used as the default options for getting the rows
+// of each column, and the returned object can also be iterated by the
+// "for await...of" statement, which yields the rows of each column.
+func Cols(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		fn := map[string]interface{}{"error": nil}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}, opts: true},
 		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+			setError(fn, args, err)
+			return js.ValueOf(fn)
+		}
+		var opts excelize.Options
+		if len(args) == 2 {
+			goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Options{}), fileStates[f].strict)
+			if err != nil {
+				setError(fn, args, err)
+				return js.ValueOf(fn)
+			}
+			opts = goVal.Elem().Interface().(excelize.Options)
 		}
-		if err := f.DeleteChart(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		cols, err := f.Cols(args[0].String())
+		if err != nil {
+			setError(fn, args, err)
+			return js.ValueOf(fn)
 		}
-		return js.ValueOf(ret)
+		return regColsFunc(f, cols, opts, fn)
 	}
 }
 
-// DeleteComment provides the method to delete comment in a sheet by given
-// worksheet name.
-func DeleteComment(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// ColsError will return an error when the error occurs.
+func ColsError(cols *excelize.Cols) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
+		if err := cols.Error(); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// ColsNext will return true if the next column is found.
+func ColsNext(cols *excelize.Cols) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		return js.ValueOf(cols.Next())
+	}
+}
+
+// ColsRows return the current column's row values. If the options are not
+// given, the options specified when creating the columns iterator will be
+// used.
+func ColsRows(cols *excelize.Cols, opts excelize.Options, strict bool) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"result": []interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}, opts: true},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		rowOpts := opts
+		if len(args) == 1 {
+			goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Options{}), strict)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			rowOpts = goVal.Elem().Interface().(excelize.Options)
+		}
+		rows, err := cols.Rows(rowOpts)
+		if err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.DeleteComment(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		result := make([]interface{}, len(rows))
+		for i, cell := range rows {
+			result[i] = cell
 		}
+		ret["result"] = result
 		return js.ValueOf(ret)
 	}
 }
@@ -1581,7 +3474,7 @@ func DeleteDataValidation(f *excelize.File) func(this js.Value, args []js.Value)
 			{types: []js.Type{js.TypeString}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if len(args) == 2 {
@@ -1590,192 +3483,264 @@ func DeleteDataValidation(f *excelize.File) func(this js.Value, args []js.Value)
 			err = f.DeleteDataValidation(args[0].String())
 		}
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// DeleteDefinedName provides a function to delete the defined names of the
-// workbook or worksheet. If not specified scope, the default scope is
-// workbook.
-func DeleteDefinedName(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// ExportCSV provides a function to export the worksheet in CSV format by given
+// worksheet name and the optional CSV options, and returns the encoded bytes.
+// The cell values will be formatted by the number format of the cells unless
+// the RawCellValue option is true, and the records are padded to the same
+// number of fields.
+func ExportCSV(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
+		ret := map[string]interface{}{"buffer": js.ValueOf([]interface{}{}), "error": nil}
 		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeObject}},
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var definedName excelize.DefinedName
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.DefinedName{}))
-		if err != nil {
-			ret["error"] = err.Error()
+		var opts csvOptions
+		if len(args) == 2 {
+			goVal, err := jsValueToGo(args[1], reflect.TypeOf(csvOptions{}), fileStates[f].strict)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = goVal.Elem().Interface().(csvOptions)
+		}
+		if err = opts.prepare(1); err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		definedName = goVal.Elem().Interface().(excelize.DefinedName)
-		if err = f.DeleteDefinedName(&definedName); err != nil {
-			ret["error"] = err.Error()
+		buf, err := exportCSV(f, args[0].String(), &opts)
+		if err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
+		dst := js.Global().Get("Uint8Array").New(len(buf))
+		js.CopyBytesToJS(dst, buf)
+		ret["buffer"] = dst
 		return js.ValueOf(ret)
 	}
 }
 
-// DeleteFormControl provides the method to delete form control in a worksheet
-// by given worksheet name and cell reference.
-func DeleteFormControl(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// csvOptions directly maps the options for the CSV format. The Quote
+// specifies the quote style of the fields: "minimal" quotes the fields which
+// contain the delimiter, quote, line breaks or the leading and trailing
+// spaces, "all" quotes all fields, "nonNumeric" quotes the fields which are
+// not numbers, and "none" writes the fields as is. The HiddenRows and
+// HiddenCols specify whether to "include" or "skip" the hidden rows and
+// columns.
+type csvOptions struct {
+	Delimiter    string
+	Quote        string
+	LineEnding   string
+	Encoding     string
+	BOM          bool
+	Range        string
+	RawCellValue bool
+	HiddenRows   string
+	HiddenCols   string
+
+	encoding    encoding.Encoding
+	coordinates []int
+}
+
+// prepare checks the CSV options and sets the default values of them, the
+// errors will be reported with the given argument index and the path of the
+// option field.
+func (opts *csvOptions) prepare(index int) error {
+	optErr := func(path string) error {
+		return &argError{err: errArgValue, index: index, path: path}
+	}
+	if opts.Delimiter == "" {
+		opts.Delimiter = ","
+	}
+	if !isCSVDelimiter(opts.Delimiter) {
+		return optErr("Delimiter")
+	}
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"Quote", &opts.Quote}, {"LineEnding", &opts.LineEnding},
+		{"HiddenRows", &opts.HiddenRows}, {"HiddenCols", &opts.HiddenCols},
+	} {
+		var ok bool
+		if *field.value, ok = optionEnum(reflect.TypeOf(csvOptions{}), field.name, *field.value); !ok {
+			return optErr(field.name)
 		}
-		if err := f.DeleteFormControl(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+	}
+	if opts.Encoding == "" {
+		opts.Encoding = "utf-8"
+	}
+	var err error
+	if opts.encoding, err = lookupEncoding(opts.Encoding); err != nil {
+		return optErr("Encoding")
+	}
+	if name, _ := htmlindex.Name(opts.encoding); opts.BOM && name != "utf-8" && name != "utf-16le" && name != "utf-16be" {
+		return optErr("BOM")
+	}
+	if opts.Range != "" {
+		if opts.coordinates, err = rangeRefToCoordinates(opts.Range); err != nil {
+			return optErr("Range")
 		}
-		return js.ValueOf(ret)
 	}
+	return nil
 }
 
-// DeletePicture provides a function to delete charts in spreadsheet by given
-// worksheet name and cell reference. Note that the image file won't be
-// deleted from the document currently.
-func DeletePicture(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if err := f.DeletePicture(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+// isCSVDelimiter checks if the given string is a single character which could
+// be used as the CSV field delimiter.
+func isCSVDelimiter(delimiter string) bool {
+	r := []rune(delimiter)
+	return len(r) == 1 && !strings.ContainsRune("\"\r\n", r[0])
+}
+
+// optionEnum returns the enumeration value of the option field by given Go
+// structure type and field name in the case defined by the optionEnums, the
+// first enumeration value will be returned for the empty string as the
+// default value. It returns false if the value is not an enumeration value.
+func optionEnum(goType reflect.Type, name, value string) (string, bool) {
+	enums := optionEnums[goType][name]
+	if value == "" {
+		return enums[0], true
+	}
+	for _, enum := range enums {
+		if strings.EqualFold(enum, value) {
+			return enum, true
 		}
-		return js.ValueOf(ret)
 	}
+	return value, false
 }
 
-// DeleteSheet provides a function to delete worksheet in a workbook by given
-// worksheet name. Use this method with caution, which will affect changes in
-// references such as formulas, charts, and so on. If there is any referenced
-// value of the deleted worksheet, it will cause a file error when you open
-// it. This function will be invalid when only one worksheet is left.
-func DeleteSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if err := f.DeleteSheet(args[0].String()); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
+// exportCSV writes the cell values of the worksheet in CSV format, and
+// returns the bytes encoded by the encoding of the options. The rows of the
+// worksheet will be read by the rows iterator until the end of the range.
+func exportCSV(f *excelize.File, sheet string, opts *csvOptions) ([]byte, error) {
+	rows, dataRows, err := readCSVRows(f, sheet, opts)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// DeleteSlicer provides the method to delete a slicer by a given slicer name.
-func DeleteSlicer(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+	coordinates := opts.coordinates
+	if coordinates == nil {
+		coordinates = []int{1, 1, 0, len(rows)}
+		for _, row := range rows {
+			coordinates[2] = max(coordinates[2], len(row))
 		}
-		if err := f.DeleteSlicer(args[0].String()); err != nil {
-			ret["error"] = err.Error()
+	}
+	var cols []int
+	for col := coordinates[0]; col <= coordinates[2]; col++ {
+		if opts.HiddenCols == "skip" {
+			name, err := excelize.ColumnNumberToName(col)
+			if err != nil {
+				return nil, err
+			}
+			visible, err := f.GetColVisible(sheet, name)
+			if err != nil {
+				return nil, err
+			}
+			if !visible {
+				continue
+			}
 		}
-		return js.ValueOf(ret)
+		cols = append(cols, col)
 	}
-}
-
-// DeleteTable provides the method to delete table by given table name.
-func DeleteTable(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+	var sb strings.Builder
+	if opts.BOM {
+		sb.WriteRune('\uFEFF')
+	}
+	fields := make([]string, len(cols))
+	for row := coordinates[1]; row <= coordinates[3]; row++ {
+		// The rows after the worksheet data have no row properties, which are
+		// visible, and GetRowVisible can't be used for them
+		if opts.HiddenRows == "skip" && row <= dataRows {
+			visible, err := f.GetRowVisible(sheet, row)
+			if err != nil {
+				return nil, err
+			}
+			if !visible {
+				continue
+			}
 		}
-		if err := f.DeleteTable(args[0].String()); err != nil {
-			ret["error"] = err.Error()
+		for i, col := range cols {
+			fields[i] = ""
+			if row <= len(rows) && col <= len(rows[row-1]) {
+				fields[i] = rows[row-1][col-1]
+			}
 		}
-		return js.ValueOf(ret)
+		writeCSVRecord(&sb, fields, opts)
+	}
+	if opts.encoding == unicode.UTF8 {
+		return []byte(sb.String()), nil
 	}
+	return encoding.ReplaceUnsupported(opts.encoding.NewEncoder()).Bytes([]byte(sb.String()))
 }
 
-// DuplicateRow inserts a copy of specified row (by its Excel row number)
-// below. Use this method with caution, which will affect changes in
-// references such as formulas, charts, and so on. If there is any referenced
-// value of the worksheet, it will cause a file error when you open it. The
-// excelize only partially updates these references currently.
-func DuplicateRow(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// readCSVRows reads the cell values of the worksheet by the rows iterator
+// until the end row of the range in the options, the trailing empty rows will
+// be trimmed. It also returns the number of the rows in the worksheet data
+// which have been iterated, including the empty rows with row properties.
+func readCSVRows(f *excelize.File, sheet string, opts *csvOptions) ([][]string, int, error) {
+	rows, err := f.Rows(sheet)
+	if err != nil {
+		return nil, 0, err
+	}
+	var (
+		results [][]string
+		cur     int
+	)
+	for (opts.coordinates == nil || cur < opts.coordinates[3]) && rows.Next() {
+		cur++
+		row, err := rows.Columns(excelize.Options{RawCellValue: opts.RawCellValue})
+		if err != nil {
+			_ = rows.Close()
+			return nil, 0, err
 		}
-		if err := f.DuplicateRow(args[0].String(), args[1].Int()); err != nil {
-			ret["error"] = err.Error()
+		if len(row) > 0 {
+			results = append(results, make([][]string, cur-len(results)-1)...)
+			results = append(results, row)
 		}
-		return js.ValueOf(ret)
 	}
+	if err = rows.Error(); err != nil {
+		_ = rows.Close()
+		return nil, 0, err
+	}
+	return results, cur, rows.Close()
 }
 
-// DuplicateRowTo inserts a copy of specified row by it Excel number to
-// specified row position moving down exists rows after target position. Use
-// this method with caution, which will affect changes in references such as
-// formulas, charts, and so on. If there is any referenced value of the
-// worksheet, it will cause a file error when you open it. The excelize only
-// partially updates these references currently.
-func DuplicateRowTo(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// writeCSVRecord writes the fields of a record in CSV format with the quote
+// style, delimiter and line ending of the options.
+func writeCSVRecord(sb *strings.Builder, fields []string, opts *csvOptions) {
+	for i, field := range fields {
+		if i > 0 {
+			sb.WriteString(opts.Delimiter)
 		}
-		if err := f.DuplicateRowTo(args[0].String(), args[1].Int(), args[2].Int()); err != nil {
-			ret["error"] = err.Error()
+		var quote bool
+		switch opts.Quote {
+		case "all":
+			quote = true
+		case "nonNumeric":
+			_, err := strconv.ParseFloat(field, 64)
+			quote = err != nil
+		case "minimal":
+			quote = strings.ContainsAny(field, opts.Delimiter+"\"\r\n") ||
+				strings.HasPrefix(field, " ") || strings.HasSuffix(field, " ")
 		}
-		return js.ValueOf(ret)
-	}
-}
-
-// GetActiveSheetIndex provides a function to get active sheet index of the
-// spreadsheet. If not found the active sheet will be return integer 0.
-func GetActiveSheetIndex(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"index": 0, "error": nil}
-		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+		if !quote {
+			sb.WriteString(field)
+			continue
 		}
-		ret["index"] = f.GetActiveSheetIndex()
-		return js.ValueOf(ret)
+		sb.WriteByte('"')
+		sb.WriteString(strings.ReplaceAll(field, `"`, `""`))
+		sb.WriteByte('"')
 	}
+	sb.WriteString(opts.LineEnding)
 }
 
 // GetAppProps provides a function to get document application properties.
@@ -1792,12 +3757,12 @@ func GetAppProps(f *excelize.File) func(this js.Value, args []js.Value) interfac
 		}
 		ret := map[string]interface{}{"error": nil}
 		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		props, err := f.GetAppProps()
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		s := reflect.ValueOf(props).Elem()
 		for i := 0; i < s.NumField(); i++ {
@@ -1819,7 +3784,7 @@ func GetBaseColor(f *excelize.File) func(this js.Value, args []js.Value) interfa
 			{types: []js.Type{js.TypeNumber}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var themeColor *int
@@ -1837,39 +3802,53 @@ func GetCalcProps(f *excelize.File) func(this js.Value, args []js.Value) interfa
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"props": map[string]interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		props, err := f.GetCalcProps()
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(props),
-			reflect.TypeOf(excelize.CalcPropsOptions{})); err == nil {
+			reflect.TypeOf(excelize.CalcPropsOptions{}), fileStates[f].bigInt); err == nil {
 			ret["props"] = jsVal
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// GetCellFormula provides a function to get formula from cell by given
-// worksheet name and cell reference in spreadsheet.
-func GetCellFormula(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// GetCellDate provides a function to get the date and time value of a cell as
+// a JavaScript Date object by given worksheet name and cell reference in
+// spreadsheet. The raw number value of the cell will be converted to the date
+// and time according to the date system of the workbook (1900 or 1904 date
+// system). The value will be null if the cell is empty or its value isn't a
+// number.
+func GetCellDate(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"formula": "", "error": nil}
-		err := prepareArgs(args, []argsRule{
+		ret := map[string]interface{}{"value": nil, "error": nil}
+		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeString}},
-		})
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		val, err := f.GetCellValue(args[0].String(), args[1].String(), excelize.Options{RawCellValue: true})
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		num, err := strconv.ParseFloat(val, 64)
 		if err != nil {
-			ret["error"] = err.Error()
 			return js.ValueOf(ret)
 		}
-		ret["formula"], err = f.GetCellFormula(args[0].String(), args[1].String())
+		t, err := excelDateToTime(f, num)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
+			return js.ValueOf(ret)
 		}
+		ret["value"] = timeToJSDate(t)
 		return js.ValueOf(ret)
 	}
 }
@@ -1885,12 +3864,12 @@ func GetCellHyperLink(f *excelize.File) func(this js.Value, args []js.Value) int
 			{types: []js.Type{js.TypeString}},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		ret["ok"], ret["location"], err = f.GetCellHyperLink(args[0].String(), args[1].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -1905,17 +3884,17 @@ func GetCellRichText(f *excelize.File) func(this js.Value, args []js.Value) inte
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		runs, err := f.GetCellRichText(args[0].String(), args[1].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		ret["runs"] = []interface{}{}
 		for i := 0; i < len(runs); i++ {
 			if jsVal, err := goValueToJS(reflect.ValueOf(runs[i]),
-				reflect.TypeOf(excelize.RichTextRun{})); err == nil {
+				reflect.TypeOf(excelize.RichTextRun{}), fileStates[f].bigInt); err == nil {
 				x := ret["runs"].([]interface{})
 				x = append(x, jsVal)
 				ret["runs"] = x
@@ -1925,27 +3904,6 @@ func GetCellRichText(f *excelize.File) func(this js.Value, args []js.Value) inte
 	}
 }
 
-// GetCellStyle provides a function to get cell style index by given
-// worksheet name and cell reference.
-func GetCellStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"style": 0, "error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		ret["style"], err = f.GetCellStyle(args[0].String(), args[1].String())
-		if err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
 // GetCellType provides a function to get the cell's data type by given
 // worksheet name and cell reference in spreadsheet file.
 func GetCellType(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
@@ -1956,13 +3914,13 @@ func GetCellType(f *excelize.File) func(this js.Value, args []js.Value) interfac
 			{types: []js.Type{js.TypeString}},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var cellType excelize.CellType
 		cellType, err = f.GetCellType(args[0].String(), args[1].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		ret["cellType"] = int(cellType)
 		return js.ValueOf(ret)
@@ -1974,7 +3932,8 @@ func GetCellType(f *excelize.File) func(this js.Value, args []js.Value) interfac
 // converted to the `string` data type. If the cell format can be applied to
 // the value of a cell, the applied value will be returned, otherwise the
 // original value will be returned. All cells' values will be the same in a
-// merged range.
+// merged range. Set the Typed field of the options to true to get the typed
+// cell value instead of the formatted string.
 func GetCellValue(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"value": "", "error": nil}
@@ -1984,81 +3943,44 @@ func GetCellValue(f *excelize.File) func(this js.Value, args []js.Value) interfa
 			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var opts excelize.Options
+		var (
+			opts          excelize.Options
+			typed, bigInt bool
+		)
 		if len(args) == 3 {
-			goVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Options{}))
+			goVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.Options{}), fileStates[f].strict, "Typed", "BigInt")
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			opts = goVal.Elem().Interface().(excelize.Options)
+			if typed, bigInt, err = typedOption(args[2]); err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
 		}
-		if ret["value"], err = f.GetCellValue(args[0].String(), args[1].String(), opts); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// GetColOutlineLevel provides a function to get outline level of a single
-// column by given worksheet name and column name.
-func GetColOutlineLevel(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"level": 0, "error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if ret["level"], err = f.GetColOutlineLevel(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// GetColStyle provides a function to get column style ID by given worksheet
-// name and column name.
-func GetColStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"style": 0, "error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if ret["style"], err = f.GetColStyle(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		if typed {
+			opts.RawCellValue = true
 		}
-		return js.ValueOf(ret)
-	}
-}
-
-// GetColVisible provides a function to get visible of a single column by given
-// worksheet name and column name.
-func GetColVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"visible": false, "error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		})
+		value, err := f.GetCellValue(args[0].String(), args[1].String(), opts)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if ret["visible"], err = f.GetColVisible(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		ret["value"] = value
+		if typed {
+			reader, err := newTypedCellReader(f, args[0].String(), bigInt)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			if ret["value"], err = reader.value(args[1].String(), value); err != nil {
+				ret["value"] = nil
+				setError(ret, args, err)
+			}
 		}
 		return js.ValueOf(ret)
 	}
@@ -2074,11 +3996,11 @@ func GetColWidth(f *excelize.File) func(this js.Value, args []js.Value) interfac
 			{types: []js.Type{js.TypeString}},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if ret["width"], err = f.GetColWidth(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -2088,7 +4010,8 @@ func GetColWidth(f *excelize.File) func(this js.Value, args []js.Value) interfac
 // given worksheet name, returned as a two-dimensional array, where the value
 // of the cell is converted to the `string` type. If the cell format can be
 // applied to the value of the cell, the applied value will be used, otherwise
-// the original value will be used.
+// the original value will be used. Set the Typed field of the options to true
+// to get the typed cell values instead of the formatted strings.
 func GetCols(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"result": js.ValueOf([]interface{}{}), "error": nil}
@@ -2097,32 +4020,37 @@ func GetCols(f *excelize.File) func(this js.Value, args []js.Value) interface{}
 			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var opts excelize.Options
+		var (
+			opts          excelize.Options
+			typed, bigInt bool
+		)
 		if len(args) == 2 {
-			goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Options{}))
+			goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Options{}), fileStates[f].strict, "Typed", "BigInt")
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			opts = goVal.Elem().Interface().(excelize.Options)
+			if typed, bigInt, err = typedOption(args[1]); err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+		}
+		if typed {
+			opts.RawCellValue = true
 		}
 		matrix, err := f.GetCols(args[0].String(), opts)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		result := make([]interface{}, len(matrix))
-		for c, col := range matrix {
-			line := make([]interface{}, len(col))
-			for r, cell := range col {
-				line[r] = cell
-			}
-			result[c] = js.ValueOf(line)
+		if ret["result"], err = matrixToJS(f, args[0].String(), matrix, typed, bigInt, true); err != nil {
+			ret["result"] = []interface{}{}
+			setError(ret, args, err)
 		}
-		ret["result"] = result
 		return js.ValueOf(ret)
 	}
 }
@@ -2134,17 +4062,17 @@ func GetComments(f *excelize.File) func(this js.Value, args []js.Value) interfac
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		cmts, err := f.GetComments(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, cmt := range cmts {
 			if jsVal, err := goValueToJS(reflect.ValueOf(cmt),
-				reflect.TypeOf(excelize.Comment{})); err == nil {
+				reflect.TypeOf(excelize.Comment{}), fileStates[f].bigInt); err == nil {
 				x := ret["comments"].([]interface{})
 				x = append(x, jsVal)
 				ret["comments"] = x
@@ -2154,6 +4082,41 @@ func GetComments(f *excelize.File) func(this js.Value, args []js.Value) interfac
 	}
 }
 
+// GetConditionalFormats returns conditional format settings by given worksheet
+// name. The result is a map of the range references to the conditional
+// formatting rules, which could be applied to other ranges by the
+// SetConditionalFormat function.
+func GetConditionalFormats(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"formats": map[string]interface{}{}, "error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		formats, err := f.GetConditionalFormats(args[0].String())
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		for ref, opts := range formats {
+			rules := []interface{}{}
+			for _, opt := range opts {
+				jsVal, err := goValueToJS(reflect.ValueOf(opt),
+					reflect.TypeOf(excelize.ConditionalFormatOptions{}), fileStates[f].bigInt)
+				if err != nil {
+					setError(ret, args, err)
+					return js.ValueOf(ret)
+				}
+				rules = append(rules, jsVal)
+			}
+			ret["formats"].(map[string]interface{})[ref] = rules
+		}
+		return js.ValueOf(ret)
+	}
+}
+
 // GetConditionalStyle returns conditional format style definition by specified
 // style index.
 func GetConditionalStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
@@ -2162,16 +4125,16 @@ func GetConditionalStyle(f *excelize.File) func(this js.Value, args []js.Value)
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeNumber}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		style, err := f.GetConditionalStyle(args[0].Int())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(*style),
-			reflect.TypeOf(excelize.Style{})); err == nil {
+			reflect.TypeOf(excelize.Style{}), fileStates[f].bigInt); err == nil {
 			ret["style"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2183,17 +4146,21 @@ func GetCustomProps(f *excelize.File) func(this js.Value, args []js.Value) inter
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"props": []interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		props, err := f.GetCustomProps()
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, prop := range props {
+			value := prop.Value
+			if t, ok := value.(time.Time); ok {
+				value = js.Global().Get("Date").New(float64(t.UnixMilli()))
+			}
 			x := ret["props"].([]interface{})
-			x = append(x, js.ValueOf(map[string]interface{}{"Name": prop.Name, "Value": prop.Value}))
+			x = append(x, js.ValueOf(map[string]interface{}{"Name": prop.Name, "Value": value}))
 			ret["props"] = x
 		}
 		return js.ValueOf(ret)
@@ -2207,17 +4174,17 @@ func GetDataValidations(f *excelize.File) func(this js.Value, args []js.Value) i
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		dataValidations, err := f.GetDataValidations(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, dv := range dataValidations {
 			if jsVal, err := goValueToJS(reflect.ValueOf(*dv),
-				reflect.TypeOf(excelize.DataValidation{})); err == nil {
+				reflect.TypeOf(excelize.DataValidation{}), fileStates[f].bigInt); err == nil {
 				x := ret["dataValidations"].([]interface{})
 				x = append(x, jsVal)
 				ret["dataValidations"] = x
@@ -2234,11 +4201,11 @@ func GetDefaultFont(f *excelize.File) func(this js.Value, args []js.Value) inter
 		ret := map[string]interface{}{"fontName": "", "error": nil}
 		err := prepareArgs(args, []argsRule{})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if ret["fontName"], err = f.GetDefaultFont(); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -2250,12 +4217,12 @@ func GetDefinedName(f *excelize.File) func(this js.Value, args []js.Value) inter
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"definedNames": []interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, dn := range f.GetDefinedName() {
 			if jsVal, err := goValueToJS(reflect.ValueOf(dn),
-				reflect.TypeOf(excelize.DefinedName{})); err == nil {
+				reflect.TypeOf(excelize.DefinedName{}), fileStates[f].bigInt); err == nil {
 				x := ret["definedNames"].([]interface{})
 				x = append(x, jsVal)
 				ret["definedNames"] = x
@@ -2270,16 +4237,16 @@ func GetDocProps(f *excelize.File) func(this js.Value, args []js.Value) interfac
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"props": map[string]interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		props, err := f.GetDocProps()
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(*props),
-			reflect.TypeOf(excelize.DocProperties{})); err == nil {
+			reflect.TypeOf(excelize.DocProperties{}), fileStates[f].bigInt); err == nil {
 			ret["props"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2295,17 +4262,17 @@ func GetFormControls(f *excelize.File) func(this js.Value, args []js.Value) inte
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		formControls, err := f.GetFormControls(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, formCtrl := range formControls {
 			if jsVal, err := goValueToJS(reflect.ValueOf(formCtrl),
-				reflect.TypeOf(excelize.FormControl{})); err == nil {
+				reflect.TypeOf(excelize.FormControl{}), fileStates[f].bigInt); err == nil {
 				x := ret["formControls"].([]interface{})
 				x = append(x, jsVal)
 				ret["formControls"] = x
@@ -2327,12 +4294,12 @@ func GetHyperLinkCells(f *excelize.File) func(this js.Value, args []js.Value) in
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		result, err := f.GetHyperLinkCells(args[0].String(), args[1].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		excepted := make([]interface{}, len(result))
 		for i, cell := range result {
@@ -2351,16 +4318,16 @@ func GetHeaderFooter(f *excelize.File) func(this js.Value, args []js.Value) inte
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts, err := f.GetHeaderFooter(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(*opts),
-			reflect.TypeOf(excelize.HeaderFooterOptions{})); err == nil {
+			reflect.TypeOf(excelize.HeaderFooterOptions{}), fileStates[f].bigInt); err == nil {
 			ret["opts"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2376,7 +4343,7 @@ func GetMergeCells(f *excelize.File) func(this js.Value, args []js.Value) interf
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeBoolean}, opts: true},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var withoutValues bool
@@ -2385,13 +4352,13 @@ func GetMergeCells(f *excelize.File) func(this js.Value, args []js.Value) interf
 		}
 		mergeCells, err := f.GetMergeCells(args[0].String(), withoutValues)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		fn := map[string]interface{}{"error": nil}
 		result := make([]interface{}, len(mergeCells))
 		for i, mergeCell := range mergeCells {
-			result[i] = regMergeCellFunc(&mergeCell, fn)
+			result[i] = regMergeCellFunc(f, &mergeCell, fn)
 		}
 		ret["mergeCells"] = js.ValueOf(result)
 		return js.ValueOf(ret)
@@ -2405,16 +4372,16 @@ func GetPageLayout(f *excelize.File) func(this js.Value, args []js.Value) interf
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts, err := f.GetPageLayout(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(opts),
-			reflect.TypeOf(excelize.PageLayoutOptions{})); err == nil {
+			reflect.TypeOf(excelize.PageLayoutOptions{}), fileStates[f].bigInt); err == nil {
 			ret["opts"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2428,16 +4395,16 @@ func GetPageMargins(f *excelize.File) func(this js.Value, args []js.Value) inter
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts, err := f.GetPageMargins(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(opts),
-			reflect.TypeOf(excelize.PageLayoutMarginsOptions{})); err == nil {
+			reflect.TypeOf(excelize.PageLayoutMarginsOptions{}), fileStates[f].bigInt); err == nil {
 			ret["opts"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2452,16 +4419,16 @@ func GetPanes(f *excelize.File) func(this js.Value, args []js.Value) interface{}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts, err := f.GetPanes(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(opts),
-			reflect.TypeOf(excelize.Panes{})); err == nil {
+			reflect.TypeOf(excelize.Panes{}), fileStates[f].bigInt); err == nil {
 			ret["panes"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2478,17 +4445,17 @@ func GetPictures(f *excelize.File) func(this js.Value, args []js.Value) interfac
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		pics, err := f.GetPictures(args[0].String(), args[1].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, pic := range pics {
 			if jsVal, err := goValueToJS(reflect.ValueOf(pic),
-				reflect.TypeOf(excelize.Picture{})); err == nil {
+				reflect.TypeOf(excelize.Picture{}), fileStates[f].bigInt); err == nil {
 				x := ret["pictures"].([]interface{})
 				x = append(x, jsVal)
 				ret["pictures"] = x
@@ -2504,12 +4471,12 @@ func GetPictureCells(f *excelize.File) func(this js.Value, args []js.Value) inte
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"cells": js.ValueOf([]interface{}{}), "error": nil}
 		if err := prepareArgs(args, []argsRule{{types: []js.Type{js.TypeString}}}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		cells, err := f.GetPictureCells(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		expected := make([]interface{}, len(cells))
@@ -2527,17 +4494,17 @@ func GetPivotTables(f *excelize.File) func(this js.Value, args []js.Value) inter
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"opts": []interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{{types: []js.Type{js.TypeString}}}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts, err := f.GetPivotTables(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, opt := range opts {
 			if jsVal, err := goValueToJS(reflect.ValueOf(opt),
-				reflect.TypeOf(excelize.PivotTableOptions{})); err == nil {
+				reflect.TypeOf(excelize.PivotTableOptions{}), fileStates[f].bigInt); err == nil {
 				x := ret["opts"].([]interface{})
 				x = append(x, jsVal)
 				ret["opts"] = x
@@ -2547,144 +4514,486 @@ func GetPivotTables(f *excelize.File) func(this js.Value, args []js.Value) inter
 	}
 }
 
-// GetRowHeight provides a function to get row height by given worksheet name
-// and row number.
-func GetRowHeight(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// GetRangeValues provides a function to get the raw numeric values of the
+// given range by given worksheet name and range reference, the values will be
+// returned as a Float64Array in row-major order, and a parallel validity
+// bitmap in the Uint8Array, the bit (i & 7) of the byte (i >> 3) specifies if
+// the i-th value is a valid number. The value of the empty cell or the cell
+// with a non-numeric raw value or a boolean value will be NaN with the
+// validity bit unset. The range will be clamped to the last row and column
+// with cells in it, and the rows and cols in the result specify the size of
+// the clamped range. The values and bitmaps of each column will be returned
+// in arrays if the ByColumn option is true. Currently, only "float64"
+// supported for the As option.
+func GetRangeValues(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"height": 15, "error": nil}
-		err := prepareArgs(args, []argsRule{
+		ret := map[string]interface{}{"values": nil, "valid": nil, "rows": 0, "cols": 0, "error": nil}
+		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		})
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}, opts: true},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var byCol bool
+		if len(args) == 3 {
+			if key, err := checkOptionFields(args[2], reflect.TypeOf(struct{}{}), fileStates[f].strict, "As", "ByColumn"); err != nil {
+				setError(ret, args, &argError{err: err, index: 2, path: key})
+				return js.ValueOf(ret)
+			}
+			if as := args[2].Get("As"); !as.IsUndefined() {
+				if jsType(as) != js.TypeString {
+					setError(ret, args, &argError{err: errArgType, index: 2, path: "As"})
+					return js.ValueOf(ret)
+				}
+				if as.String() != "float64" {
+					setError(ret, args, &argError{err: excelize.ErrParameterInvalid, index: 2, path: "As"})
+					return js.ValueOf(ret)
+				}
+			}
+			if byColumn := args[2].Get("ByColumn"); !byColumn.IsUndefined() {
+				if jsType(byColumn) != js.TypeBoolean {
+					setError(ret, args, &argError{err: errArgType, index: 2, path: "ByColumn"})
+					return js.ValueOf(ret)
+				}
+				byCol = byColumn.Bool()
+			}
+		}
+		coordinates, err := rangeRefToCoordinates(args[1].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if ret["height"], err = f.GetRowHeight(args[0].String(), args[1].Int()); err != nil {
-			ret["error"] = err.Error()
+		values, valid, err := getRangeValues(f, args[0].String(), coordinates)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		rows, cols := coordinates[3]-coordinates[1]+1, coordinates[2]-coordinates[0]+1
+		ret["rows"], ret["cols"] = rows, cols
+		if !byCol {
+			ret["values"], ret["valid"] = float64sToJS(values), bitmapToJS(valid)
+			return js.ValueOf(ret)
+		}
+		colValues, colValid := make([]interface{}, cols), make([]interface{}, cols)
+		for c := 0; c < cols; c++ {
+			col, colBits := make([]float64, rows), make([]bool, rows)
+			for r := 0; r < rows; r++ {
+				col[r], colBits[r] = values[r*cols+c], valid[r*cols+c]
+			}
+			colValues[c], colValid[c] = float64sToJS(col), bitmapToJS(colBits)
 		}
+		ret["values"], ret["valid"] = colValues, colValid
 		return js.ValueOf(ret)
 	}
 }
 
-// GetRowOutlineLevel provides a function to get outline level number of a
-// single row by given worksheet name and Excel row number.
-func GetRowOutlineLevel(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"level": 0, "error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		})
+// rangeRefToCoordinates provides a function to convert range reference to a
+// pair of coordinates in order of [x1, y1, x2, y2], a single cell reference
+// will be treated as a range with the same top-left and bottom-right cell.
+func rangeRefToCoordinates(ref string) ([]int, error) {
+	cells := strings.Split(ref, ":")
+	if len(cells) == 1 {
+		cells = append(cells, cells[0])
+	}
+	if len(cells) != 2 {
+		return nil, excelize.ErrParameterInvalid
+	}
+	coordinates := make([]int, 4)
+	for i, cell := range cells {
+		col, row, err := excelize.CellNameToCoordinates(cell)
 		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+			return nil, err
 		}
-		if ret["level"], err = f.GetRowOutlineLevel(args[0].String(), args[1].Int()); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
+		coordinates[i*2], coordinates[i*2+1] = col, row
+	}
+	if coordinates[2] < coordinates[0] {
+		coordinates[0], coordinates[2] = coordinates[2], coordinates[0]
+	}
+	if coordinates[3] < coordinates[1] {
+		coordinates[1], coordinates[3] = coordinates[3], coordinates[1]
 	}
+	return coordinates, nil
 }
 
-// GetRowVisible provides a function to get visible of a single row by given
-// worksheet name and Excel row number.
-func GetRowVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"visible": false, "error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		})
+// getRangeValues provides a function to read the raw numeric values of the
+// given range coordinates in row-major order by the rows iterator, and
+// returns the values with the validity of each value. The bottom-right
+// corner of the coordinates will be clamped to the last row and column with
+// cells in the range, so the values of a whole worksheet range like
+// A1:XFD1048576 only take the memory of the used cells.
+func getRangeValues(f *excelize.File, sheet string, coordinates []int) ([]float64, []bool, error) {
+	iter, err := f.Rows(sheet)
+	if err != nil {
+		return nil, nil, err
+	}
+	var cells [][]string
+	lastRow, lastCol := coordinates[1]-1, coordinates[0]-1
+	for row := 1; row <= coordinates[3] && iter.Next(); row++ {
+		if row < coordinates[1] {
+			continue
+		}
+		columns, err := iter.Columns(excelize.Options{RawCellValue: true})
 		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+			_ = iter.Close()
+			return nil, nil, err
 		}
-		if ret["visible"], err = f.GetRowVisible(args[0].String(), args[1].Int()); err != nil {
-			ret["error"] = err.Error()
+		if len(columns) > coordinates[2] {
+			columns = columns[:coordinates[2]]
+		}
+		if len(columns) < coordinates[0] {
+			cells = append(cells, nil)
+			continue
+		}
+		cells = append(cells, columns[coordinates[0]-1:])
+		lastRow, lastCol = row, max(lastCol, len(columns))
+	}
+	if err = iter.Error(); err != nil {
+		_ = iter.Close()
+		return nil, nil, err
+	}
+	if err = iter.Close(); err != nil {
+		return nil, nil, err
+	}
+	coordinates[2], coordinates[3] = lastCol, lastRow
+	rows, cols := coordinates[3]-coordinates[1]+1, coordinates[2]-coordinates[0]+1
+	values, valid := make([]float64, rows*cols), make([]bool, rows*cols)
+	for i := range values {
+		values[i] = math.NaN()
+	}
+	for r := 0; r < rows; r++ {
+		for c, value := range cells[r] {
+			num, err := strconv.ParseFloat(value, 64)
+			if err != nil || math.IsNaN(num) || math.IsInf(num, 0) {
+				continue
+			}
+			// The raw value of the boolean cell is 1 or 0, which isn't a
+			// numeric value
+			if num == 0 || num == 1 {
+				cell, err := excelize.CoordinatesToCellName(coordinates[0]+c, coordinates[1]+r)
+				if err != nil {
+					return nil, nil, err
+				}
+				cellType, err := f.GetCellType(sheet, cell)
+				if err != nil {
+					return nil, nil, err
+				}
+				if cellType == excelize.CellTypeBool {
+					continue
+				}
+			}
+			values[r*cols+c], valid[r*cols+c] = num, true
 		}
-		return js.ValueOf(ret)
 	}
+	return values, valid, nil
 }
 
-// GetSheetDimension provides the method to get the used range of the worksheet.
-func GetSheetDimension(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"dimension": false, "error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if ret["dimension"], err = f.GetSheetDimension(args[0].String()); err != nil {
-			ret["error"] = err.Error()
+// float64sToJS provides a function to copy the float numbers into a
+// Float64Array by js.CopyBytesToJS.
+func float64sToJS(values []float64) js.Value {
+	buf := make([]byte, len(values)*8)
+	for i, value := range values {
+		binary.LittleEndian.PutUint64(buf[i*8:], math.Float64bits(value))
+	}
+	dst := js.Global().Get("Float64Array").New(len(values))
+	js.CopyBytesToJS(js.Global().Get("Uint8Array").New(dst.Get("buffer")), buf)
+	return dst
+}
+
+// bitmapToJS provides a function to copy the validity of each value into a
+// bitmap in the Uint8Array by js.CopyBytesToJS.
+func bitmapToJS(valid []bool) js.Value {
+	buf := make([]byte, (len(valid)+7)/8)
+	for i, ok := range valid {
+		if ok {
+			buf[i>>3] |= 1 << (i & 7)
 		}
-		return js.ValueOf(ret)
 	}
+	dst := js.Global().Get("Uint8Array").New(len(buf))
+	js.CopyBytesToJS(dst, buf)
+	return dst
 }
 
-// GetRows return all the rows in a sheet by given worksheet name, returned as
-// a two-dimensional array, where the value of the cell is converted to the
-// string type. If the cell format can be applied to the value of the cell,
-// the applied value will be used, otherwise the original value will be used.
-// GetRows fetched the rows with value or formula cells, the continually blank
-// cells in the tail of each row will be skipped, so the length of each row
-// may be inconsistent.
-func GetRows(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// GetRecords provides a function to get the rows of the worksheet as records
+// keyed by the header cell text by given worksheet name and the optional
+// records options. The header row is the first row of the range by default,
+// the cells of the merged header cell will get the value of the merged cell,
+// the duplicate headers will be suffixed with the sequence number, such as
+// "Name_2", and the empty headers will be replaced with the column name. Set
+// the Typed field of the options to true to get the typed cell values.
+func GetRecords(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"result": js.ValueOf([]interface{}{}), "error": nil}
+		ret := map[string]interface{}{"records": js.ValueOf([]interface{}{}), "error": nil}
 		err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var opts excelize.Options
+		var opts recordsOptions
 		if len(args) == 2 {
-			goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Options{}))
+			goVal, err := jsValueToGo(args[1], reflect.TypeOf(recordsOptions{}), fileStates[f].strict)
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
-			opts = goVal.Elem().Interface().(excelize.Options)
+			opts = goVal.Elem().Interface().(recordsOptions)
 		}
-		matrix, err := f.GetRows(args[0].String(), opts)
+		if err = opts.prepare(1); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		records, err := getRecords(f, args[0].String(), &opts)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		result := make([]interface{}, len(matrix))
-		for r, row := range matrix {
-			line := make([]interface{}, len(row))
-			for c, cell := range row {
-				line[c] = cell
+		ret["records"] = records
+		return js.ValueOf(ret)
+	}
+}
+
+// recordsOptions directly maps the options for getting the records. The
+// HeaderRow specifies the row number of the header row, and the SkipEmpty
+// specifies whether to skip the rows in which all cells are empty.
+type recordsOptions struct {
+	HeaderRow    int
+	Range        string
+	SkipEmpty    bool
+	Typed        bool
+	BigInt       bool
+	RawCellValue bool
+
+	coordinates []int
+}
+
+// prepare checks the records options, the errors will be reported with the
+// given argument index and the path of the option field.
+func (opts *recordsOptions) prepare(index int) error {
+	if opts.HeaderRow < 0 || opts.HeaderRow > excelize.TotalRows {
+		return &argError{err: errArgValue, index: index, path: "HeaderRow"}
+	}
+	if opts.Range == "" {
+		return nil
+	}
+	var err error
+	if opts.coordinates, err = rangeRefToCoordinates(opts.Range); err != nil {
+		return &argError{err: errArgValue, index: index, path: "Range"}
+	}
+	if opts.HeaderRow != 0 && (opts.HeaderRow < opts.coordinates[1] || opts.HeaderRow > opts.coordinates[3]) {
+		return &argError{err: errArgValue, index: index, path: "HeaderRow"}
+	}
+	return nil
+}
+
+// getRecords returns the rows below the header row in the range of the
+// worksheet as JavaScript objects keyed by the headers.
+func getRecords(f *excelize.File, sheet string, opts *recordsOptions) ([]interface{}, error) {
+	rows, err := f.GetRows(sheet, excelize.Options{RawCellValue: opts.Typed || opts.RawCellValue})
+	if err != nil {
+		return nil, err
+	}
+	coordinates := opts.coordinates
+	if coordinates == nil {
+		coordinates = []int{1, 1, 1, len(rows)}
+		for _, row := range rows {
+			coordinates[2] = max(coordinates[2], len(row))
+		}
+	}
+	headerRow := opts.HeaderRow
+	if headerRow == 0 {
+		headerRow = coordinates[1]
+	}
+	records := []interface{}{}
+	if headerRow > coordinates[3] {
+		return records, nil
+	}
+	headers, err := recordHeaders(f, sheet, headerRow, coordinates[0], coordinates[2])
+	if err != nil {
+		return nil, err
+	}
+	var reader *typedCellReader
+	if opts.Typed {
+		if reader, err = newTypedCellReader(f, sheet, opts.BigInt); err != nil {
+			return nil, err
+		}
+	}
+	for row := headerRow + 1; row <= coordinates[3]; row++ {
+		record, empty := js.Global().Get("Object").New(), true
+		for i, header := range headers {
+			col := coordinates[0] + i
+			var value interface{}
+			if row <= len(rows) && col <= len(rows[row-1]) {
+				value = rows[row-1][col-1]
+			}
+			raw, _ := value.(string)
+			empty = empty && raw == ""
+			if reader != nil {
+				cell, err := excelize.CoordinatesToCellName(col, row)
+				if err != nil {
+					return nil, err
+				}
+				if value, err = reader.value(cell, raw); err != nil {
+					return nil, err
+				}
+			}
+			if value == nil && reader == nil {
+				value = ""
+			}
+			setRecordField(record, header, value)
+		}
+		if empty && opts.SkipEmpty {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// setRecordField sets the field of the record by given header and value. The
+// "__proto__" header will be defined as an own field of the record, because
+// setting it will change the prototype of the record instead.
+func setRecordField(record js.Value, header string, value interface{}) {
+	if header != "__proto__" {
+		record.Set(header, value)
+		return
+	}
+	js.Global().Get("Object").Call("defineProperty", record, header, map[string]interface{}{
+		"value": value, "writable": true, "enumerable": true, "configurable": true,
+	})
+}
+
+// recordHeaders returns the headers of the records by given header row and
+// the columns range. The cells of the merged header cell get the value of
+// the merged cell, the duplicate headers will be suffixed with the sequence
+// number, and the empty headers will be replaced with the column name.
+func recordHeaders(f *excelize.File, sheet string, row, col1, col2 int) ([]string, error) {
+	headers := make([]string, col2-col1+1)
+	for i := range headers {
+		cell, err := excelize.CoordinatesToCellName(col1+i, row)
+		if err != nil {
+			return nil, err
+		}
+		if headers[i], err = f.GetCellValue(sheet, cell); err != nil {
+			return nil, err
+		}
+	}
+	mergeCells, err := f.GetMergeCells(sheet)
+	if err != nil {
+		return nil, err
+	}
+	for _, mergeCell := range mergeCells {
+		coordinates, err := rangeRefToCoordinates(mergeCell.GetStartAxis() + ":" + mergeCell.GetEndAxis())
+		if err != nil || row < coordinates[1] || row > coordinates[3] {
+			continue
+		}
+		for col := max(coordinates[0], col1); col <= min(coordinates[2], col2); col++ {
+			headers[col-col1] = mergeCell.GetCellValue()
+		}
+	}
+	seen := make(map[string]bool, len(headers))
+	for i, header := range headers {
+		if header = strings.TrimSpace(header); header == "" {
+			if header, err = excelize.ColumnNumberToName(col1 + i); err != nil {
+				return nil, err
 			}
-			result[r] = js.ValueOf(line)
 		}
-		ret["result"] = result
+		name := header
+		for n := 2; seen[name]; n++ {
+			name = header + "_" + strconv.Itoa(n)
+		}
+		headers[i], seen[name] = name, true
+	}
+	return headers, nil
+}
+
+// GetRowHeight provides a function to get row height by given worksheet name
+// and row number.
+func GetRowHeight(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"height": 15, "error": nil}
+		err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeNumber}},
+		})
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["height"], err = f.GetRowHeight(args[0].String(), args[1].Int()); err != nil {
+			setError(ret, args, err)
+		}
 		return js.ValueOf(ret)
 	}
 }
 
-// GetSheetIndex provides a function to get a sheet index of the workbook by
-// the given sheet name. If the given sheet name is invalid or sheet doesn't
-// exist, it will return an integer type value -1.
-func GetSheetIndex(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// GetSheetDimension provides the method to get the used range of the worksheet.
+func GetSheetDimension(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"index": 0, "error": nil}
+		ret := map[string]interface{}{"dimension": false, "error": nil}
+		err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+		})
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		if ret["dimension"], err = f.GetSheetDimension(args[0].String()); err != nil {
+			setError(ret, args, err)
+		}
+		return js.ValueOf(ret)
+	}
+}
+
+// GetRows return all the rows in a sheet by given worksheet name, returned as
+// a two-dimensional array, where the value of the cell is converted to the
+// string type. If the cell format can be applied to the value of the cell,
+// the applied value will be used, otherwise the original value will be used.
+// GetRows fetched the rows with value or formula cells, the continually blank
+// cells in the tail of each row will be skipped, so the length of each row
+// may be inconsistent. Set the Typed field of the options to true to get the
+// typed cell values instead of the formatted strings.
+func GetRows(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"result": js.ValueOf([]interface{}{}), "error": nil}
 		err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var (
+			opts          excelize.Options
+			typed, bigInt bool
+		)
+		if len(args) == 2 {
+			goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Options{}), fileStates[f].strict, "Typed", "BigInt")
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = goVal.Elem().Interface().(excelize.Options)
+			if typed, bigInt, err = typedOption(args[1]); err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+		}
+		if typed {
+			opts.RawCellValue = true
+		}
+		matrix, err := f.GetRows(args[0].String(), opts)
+		if err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if ret["index"], err = f.GetSheetIndex(args[0].String()); err != nil {
-			ret["error"] = err.Error()
+		if ret["result"], err = matrixToJS(f, args[0].String(), matrix, typed, bigInt, false); err != nil {
+			ret["result"] = []interface{}{}
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -2696,7 +5005,7 @@ func GetSheetList(f *excelize.File) func(this js.Value, args []js.Value) interfa
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"list": js.ValueOf([]interface{}{}), "error": nil}
 		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		sheetList := f.GetSheetList()
@@ -2715,7 +5024,7 @@ func GetSheetMap(f *excelize.File) func(this js.Value, args []js.Value) interfac
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"sheets": js.ValueOf(map[string]interface{}{}), "error": nil}
 		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		sheetMap := f.GetSheetMap()
@@ -2728,23 +5037,6 @@ func GetSheetMap(f *excelize.File) func(this js.Value, args []js.Value) interfac
 	}
 }
 
-// GetSheetName provides a function to get the sheet name of the workbook by
-// the given sheet index. If the given sheet index is invalid, it will return
-// an empty string.
-func GetSheetName(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"name": "", "error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		ret["name"] = f.GetSheetName(args[0].Int())
-		return js.ValueOf(ret)
-	}
-}
-
 // GetSheetProps provides a function to get worksheet properties.
 func GetSheetProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
@@ -2752,16 +5044,16 @@ func GetSheetProps(f *excelize.File) func(this js.Value, args []js.Value) interf
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		props, err := f.GetSheetProps(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(props),
-			reflect.TypeOf(excelize.SheetPropsOptions{})); err == nil {
+			reflect.TypeOf(excelize.SheetPropsOptions{}), fileStates[f].bigInt); err == nil {
 			ret["props"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2777,16 +5069,16 @@ func GetSheetProtection(f *excelize.File) func(this js.Value, args []js.Value) i
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		props, err := f.GetSheetProtection(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(props),
-			reflect.TypeOf(excelize.SheetProtectionOptions{})); err == nil {
+			reflect.TypeOf(excelize.SheetProtectionOptions{}), fileStates[f].bigInt); err == nil {
 			ret["opts"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2802,16 +5094,16 @@ func GetSheetView(f *excelize.File) func(this js.Value, args []js.Value) interfa
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeNumber}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		opts, err := f.GetSheetView(args[0].String(), args[1].Int())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(opts),
-			reflect.TypeOf(excelize.ViewOptions{})); err == nil {
+			reflect.TypeOf(excelize.ViewOptions{}), fileStates[f].bigInt); err == nil {
 			ret["opts"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2827,11 +5119,11 @@ func GetSheetVisible(f *excelize.File) func(this js.Value, args []js.Value) inte
 			{types: []js.Type{js.TypeString}},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if ret["visible"], err = f.GetSheetVisible(args[0].String()); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -2846,17 +5138,17 @@ func GetSlicers(f *excelize.File) func(this js.Value, args []js.Value) interface
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		slicers, err := f.GetSlicers(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, slicer := range slicers {
 			if jsVal, err := goValueToJS(reflect.ValueOf(slicer),
-				reflect.TypeOf(excelize.SlicerOptions{})); err == nil {
+				reflect.TypeOf(excelize.SlicerOptions{}), fileStates[f].bigInt); err == nil {
 				x := ret["slicers"].([]interface{})
 				x = append(x, jsVal)
 				ret["slicers"] = x
@@ -2873,16 +5165,16 @@ func GetStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeNumber}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		style, err := f.GetStyle(args[0].Int())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(*style),
-			reflect.TypeOf(excelize.Style{})); err == nil {
+			reflect.TypeOf(excelize.Style{}), fileStates[f].bigInt); err == nil {
 			ret["style"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2897,17 +5189,17 @@ func GetTables(f *excelize.File) func(this js.Value, args []js.Value) interface{
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		tables, err := f.GetTables(args[0].String())
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		for _, tbl := range tables {
 			if jsVal, err := goValueToJS(reflect.ValueOf(tbl),
-				reflect.TypeOf(excelize.Table{})); err == nil {
+				reflect.TypeOf(excelize.Table{}), fileStates[f].bigInt); err == nil {
 				x := ret["tables"].([]interface{})
 				x = append(x, jsVal)
 				ret["tables"] = x
@@ -2922,16 +5214,16 @@ func GetWorkbookProps(f *excelize.File) func(this js.Value, args []js.Value) int
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"props": map[string]interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		props, err := f.GetWorkbookProps()
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if jsVal, err := goValueToJS(reflect.ValueOf(props),
-			reflect.TypeOf(excelize.WorkbookPropsOptions{})); err == nil {
+			reflect.TypeOf(excelize.WorkbookPropsOptions{}), fileStates[f].bigInt); err == nil {
 			ret["props"] = jsVal
 		}
 		return js.ValueOf(ret)
@@ -2946,155 +5238,274 @@ func GroupSheets(f *excelize.File) func(this js.Value, args []js.Value) interfac
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		length := args[0].Length()
 		var slice []string
 		for i := 0; i < length; i++ {
 			arg := args[0].Index(i)
-			switch arg.Type() {
+			switch jsType(arg) {
 			case js.TypeString:
 				slice = append(slice, arg.String())
 			default:
 			}
 		}
 		if err := f.GroupSheets(slice); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// InsertCols provides a function to insert new columns before the given column
-// name and number of columns.
-//
-// Use this method with caution, which will affect changes in references such
-// as formulas, charts, and so on. If there is any referenced value of the
-// worksheet, it will cause a file error when you open it. The excelize only
-// partially updates these references currently.
-func InsertCols(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// ImportCSV provides a function to import the CSV data into the worksheet by
+// given worksheet name, top-left cell reference, CSV bytes or string and the
+// optional import options. The fields are parsed in RFC 4180 quoting, and the
+// delimiter will be detected from the first records if it's not specified.
+// The fields will be written as strings unless the InferTypes option is true,
+// which writes the numbers, booleans, percentages and ISO 8601 dates with the
+// proper cell types, and applies the percentage and date number formats. The
+// empty fields will be skipped.
+func ImportCSV(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
+		err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
+			{types: []js.Type{js.TypeString, js.TypeObject}},
+			{types: []js.Type{js.TypeObject}, opts: true},
+		})
+		if err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.InsertCols(args[0].String(), args[1].String(), args[2].Int()); err != nil {
-			ret["error"] = err.Error()
+		var opts csvImportOptions
+		if len(args) == 4 {
+			goVal, err := jsValueToGo(args[3], reflect.TypeOf(csvImportOptions{}), fileStates[f].strict)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = goVal.Elem().Interface().(csvImportOptions)
 		}
-		return js.ValueOf(ret)
-	}
-}
-
-// InsertPageBreak create a page break to determine where the printed page ends
-// and where begins the next one by given worksheet name and cell, so the
-// content before the page break will be printed on one page and after the
-// page break on another.
-func InsertPageBreak(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
+		if err = opts.prepare(3); err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.InsertPageBreak(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		importCSV := func(buf []byte, err error) interface{} {
+			if err == nil {
+				err = opts.importCSV(f, args[0].String(), args[1].String(), buf)
+			}
+			if err != nil {
+				setError(ret, args, err)
+			}
+			return js.ValueOf(ret)
 		}
-		return js.ValueOf(ret)
+		if jsType(args[2]) == js.TypeString {
+			return importCSV([]byte(args[2].String()), nil)
+		}
+		if isJSByteStream(args[2]) {
+			return newPromise(fileStates[f].throwOnError, func() interface{} {
+				return importCSV(readJSBytes(args[2]))
+			})
+		}
+		return importCSV(jsBytesToGo(args[2]))
 	}
 }
 
-// InsertRows provides a function to insert new rows after the given Excel
-// row number starting from 1 and number of rows.
-//
-// Use this method with caution, which will affect changes in references such
-// as formulas, charts, and so on. If there is any referenced value of the
-// worksheet, it will cause a file error when you open it. The excelize only
-// partially updates these references currently.
-func InsertRows(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// csvImportOptions directly maps the options for importing the CSV data. The
+// Delimiter will be detected if it's empty, and the Encoding specifies the
+// encoding of the CSV bytes, the byte order mark will be removed.
+type csvImportOptions struct {
+	Delimiter  string
+	Encoding   string
+	InferTypes bool
+
+	encoding encoding.Encoding
+	styles   map[int]int
+}
+
+var (
+	// csvDelimiters defined the candidate delimiters for detecting the CSV
+	// field delimiter.
+	csvDelimiters = []rune{',', '\t', ';', '|'}
+	// csvNumberExp defined the expression of the numbers which will be
+	// inferred as numeric cell values, the numbers with leading zeros will be
+	// kept as strings.
+	csvNumberExp = regexp.MustCompile(`^[-+]?(0|[1-9]\d*)?(\.\d+)?([eE][-+]?\d+)?$`)
+	// csvDateLayouts defined the layouts of the dates which will be inferred
+	// as date cell values, and the number format ID of them.
+	csvDateLayouts = []struct {
+		layout string
+		numFmt int
+	}{
+		{"2006-01-02", 14},
+		{"2006-01-02 15:04", 22},
+		{"2006-01-02 15:04:05", 22},
+		{"2006-01-02T15:04", 22},
+		{"2006-01-02T15:04:05", 22},
+	}
+)
+
+// prepare checks the CSV import options and sets the default values of them,
+// the errors will be reported with the given argument index and the path of
+// the option field.
+func (opts *csvImportOptions) prepare(index int) error {
+	if opts.Delimiter != "" && !isCSVDelimiter(opts.Delimiter) {
+		return &argError{err: errArgValue, index: index, path: "Delimiter"}
+	}
+	if opts.Encoding == "" {
+		opts.Encoding = "utf-8"
+	}
+	var err error
+	if opts.encoding, err = lookupEncoding(opts.Encoding); err != nil {
+		return &argError{err: errArgValue, index: index, path: "Encoding"}
+	}
+	return nil
+}
+
+// importCSV decodes and parses the CSV bytes, and writes the records into
+// the worksheet from the given top-left cell.
+func (opts *csvImportOptions) importCSV(f *excelize.File, sheet, cell string, buf []byte) error {
+	col, row, err := excelize.CellNameToCoordinates(cell)
+	if err != nil {
+		return err
+	}
+	if _, err = f.GetSheetDimension(sheet); err != nil {
+		return err
+	}
+	text, _, err := transform.String(unicode.BOMOverride(opts.encoding.NewDecoder()), string(buf))
+	if err != nil {
+		return err
+	}
+	delimiter := detectCSVDelimiter(text)
+	if opts.Delimiter != "" {
+		delimiter = []rune(opts.Delimiter)[0]
+	}
+	r := csv.NewReader(strings.NewReader(text))
+	r.Comma, r.FieldsPerRecord, r.ReuseRecord = delimiter, -1, true
+	for ; ; row++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
 		}
-		if err := f.InsertRows(args[0].String(), args[1].Int(), args[2].Int()); err != nil {
-			ret["error"] = err.Error()
+		for i, field := range record {
+			if field == "" {
+				continue
+			}
+			cell, err := excelize.CoordinatesToCellName(col+i, row)
+			if err != nil {
+				return err
+			}
+			if err = opts.setCellValue(f, sheet, cell, field); err != nil {
+				return err
+			}
 		}
-		return js.ValueOf(ret)
 	}
 }
 
-// MergeCell provides a function to merge cells by given range reference and
-// sheet name. Merging cells only keeps the upper-left cell value, and
-// discards the other values.
-//
-// If you create a merged cell that overlaps with another existing merged
-// cell, those merged cells that already exist will be removed. The cell
-// references tuple after merging in the following range will be: A1
-// (x3,y1) D1(x2,y1) A8(x3,y4) D8(x2,y4)
-//
-//	             B1(x1,y1)      D1(x2,y1)
-//	           +------------------------+
-//	           |                        |
-//	 A4(x3,y3) |    C4(x4,y3)           |
-//	+------------------------+          |
-//	|          |             |          |
-//	|          |B5(x1,y2)    | D5(x2,y2)|
-//	|          +------------------------+
-//	|                        |
-//	|A8(x3,y4)      C8(x4,y4)|
-//	+------------------------+
-func MergeCell(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// setCellValue writes the CSV field into the cell, the type of the field
+// will be inferred if the InferTypes option is true.
+func (opts *csvImportOptions) setCellValue(f *excelize.File, sheet, cell, field string) error {
+	if !opts.InferTypes {
+		return f.SetCellStr(sheet, cell, field)
+	}
+	if value, err := strconv.ParseBool(field); err == nil && len(field) > 1 {
+		return f.SetCellBool(sheet, cell, value)
+	}
+	if value, ok := parseCSVNumber(field); ok {
+		return f.SetCellFloat(sheet, cell, value, -1, 64)
+	}
+	if number := strings.TrimSuffix(field, "%"); number != field {
+		if value, ok := parseCSVNumber(number); ok {
+			numFmt := 9
+			if strings.Contains(number, ".") {
+				numFmt = 10
+			}
+			if err := f.SetCellFloat(sheet, cell, value/100, -1, 64); err != nil {
+				return err
+			}
+			return opts.setCellNumFmt(f, sheet, cell, numFmt)
 		}
-		if err := f.MergeCell(args[0].String(), args[1].String(), args[2].String()); err != nil {
-			ret["error"] = err.Error()
+	}
+	for _, date := range csvDateLayouts {
+		if value, err := time.Parse(date.layout, field); err == nil {
+			if err = f.SetCellValue(sheet, cell, value); err != nil {
+				return err
+			}
+			return opts.setCellNumFmt(f, sheet, cell, date.numFmt)
 		}
-		return js.ValueOf(ret)
 	}
+	return f.SetCellStr(sheet, cell, field)
 }
 
-// MoveSheet moves a sheet to a specified position in the workbook. The function
-// moves the source sheet before the target sheet. After moving, other sheets
-// will be shifted to the left or right. If the sheet is already at the target
-// position, the function will not perform any action. Not that this function
-// will be ungroup all sheets after moving.
-func MoveSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// setCellNumFmt sets the style with the built-in number format for the cell,
+// the styles will be created once for each number format.
+func (opts *csvImportOptions) setCellNumFmt(f *excelize.File, sheet, cell string, numFmt int) error {
+	if opts.styles == nil {
+		opts.styles = make(map[int]int)
+	}
+	styleID, ok := opts.styles[numFmt]
+	if !ok {
+		var err error
+		if styleID, err = f.NewStyle(&excelize.Style{NumFmt: numFmt}); err != nil {
+			return err
+		}
+		opts.styles[numFmt] = styleID
+	}
+	return f.SetCellStyle(sheet, cell, cell, styleID)
+}
+
+// parseCSVNumber parses the CSV field as a number, the numbers with leading
+// zeros or more than 15 significant digits will not be parsed to avoid losing
+// the leading zeros and precision.
+func parseCSVNumber(field string) (float64, bool) {
+	if !csvNumberExp.MatchString(field) || strings.Trim(field, "+-.") == "" {
+		return 0, false
+	}
+	mantissa, _, _ := strings.Cut(strings.ToLower(field), "e")
+	if digits := strings.TrimLeft(strings.NewReplacer("+", "", "-", "", ".", "").Replace(mantissa), "0"); len(digits) > 15 {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(field, 64)
+	return value, err == nil
+}
+
+// detectCSVDelimiter detects the CSV field delimiter by the first records of
+// the CSV text, the candidate delimiter which splits the records into the
+// same and most number of fields will be used, and the comma will be used if
+// no candidate delimiter found.
+func detectCSVDelimiter(text string) rune {
+	delimiter, maxFields, consistent := ',', 1, false
+	for _, candidate := range csvDelimiters {
+		r := csv.NewReader(strings.NewReader(text))
+		r.Comma, r.FieldsPerRecord = candidate, -1
+		fields, same := 0, true
+		for i := 0; i < 10; i++ {
+			record, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				same = false
+				break
+			}
+			if i == 0 {
+				fields = len(record)
+			}
+			same = same && len(record) == fields
+		}
+		if fields <= 1 || (consistent && !same) {
+			continue
 		}
-		if err := f.MoveSheet(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		if (same && !consistent) || fields > maxFields {
+			delimiter, maxFields, consistent = candidate, fields, same
 		}
-		return js.ValueOf(ret)
 	}
+	return delimiter
 }
 
 // NewConditionalStyle provides a function to create style for conditional
@@ -3108,18 +5519,18 @@ func NewConditionalStyle(f *excelize.File) func(this js.Value, args []js.Value)
 			{types: []js.Type{js.TypeObject}},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var style excelize.Style
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Style{}))
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Style{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		style = goVal.Elem().Interface().(excelize.Style)
 		if ret["style"], err = f.NewConditionalStyle(&style); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -3136,16 +5547,41 @@ func NewSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{}
 			{types: []js.Type{js.TypeString}},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if ret["index"], err = f.NewSheet(args[0].String()); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
+// NewStreamWriter returns stream writer struct by given worksheet name used
+// for writing data on a new existing empty worksheet with large amounts of
+// data. Note that after writing data with the stream writer for the
+// worksheet, you must call the 'Flush' method to end the streaming writing
+// process, ensure that the order of row numbers is ascending when set rows,
+// and the normal mode functions and stream mode functions can not be work
+// mixed to writing data on the worksheets.
+func NewStreamWriter(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		fn := map[string]interface{}{"error": nil}
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeString}},
+		}); err != nil {
+			setError(fn, args, err)
+			return js.ValueOf(fn)
+		}
+		sw, err := f.NewStreamWriter(args[0].String())
+		if err != nil {
+			setError(fn, args, err)
+			return js.ValueOf(fn)
+		}
+		return regStreamWriterFunc(f, sw, fn)
+	}
+}
+
 // NewStyle provides a function to create the style for cells by given options.
 // Note that the color field uses RGB color code.
 func NewStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
@@ -3155,404 +5591,620 @@ func NewStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{}
 			{types: []js.Type{js.TypeObject}},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var style excelize.Style
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Style{}))
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Style{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		style = goVal.Elem().Interface().(excelize.Style)
 		if ret["style"], err = f.NewStyle(&style); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// ProtectSheet provides a function to prevent other users from accidentally or
-// deliberately changing, moving, or deleting data in a worksheet. The
-// optional field AlgorithmName specified hash algorithm, support XOR, MD4,
-// MD5, SHA-1, SHA2-56, SHA-384, and SHA-512 currently, if no hash algorithm
-// specified, will be using the XOR algorithm as default.
-func ProtectSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// RenderHTML provides a function to render the cells of the worksheet as an
+// HTML table with inline styles by given worksheet name, range reference and
+// the optional render options. The used range of the worksheet will be
+// rendered if the range reference is empty. The fonts, fills, borders,
+// alignments and number formats of the cells, the merged cells, column
+// widths, row heights, hyperlinks and rich text will be rendered, and the
+// hidden rows and columns will be omitted unless the ShowHidden option is
+// true. Only the hyperlinks with the http, https, ftp and mailto schemes will
+// be rendered as links to external resources, others will be rendered as the
+// fragment of the location. Rendering doesn't change the worksheet, the empty
+// cells after the last cell with value in a row will be rendered with the
+// style of the row or column.
+func RenderHTML(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
+		ret := map[string]interface{}{"html": "", "error": nil}
+		err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.SheetProtectionOptions
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.SheetProtectionOptions{}))
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}, opts: true},
+		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		opts = goVal.Elem().Interface().(excelize.SheetProtectionOptions)
-		if err := f.ProtectSheet(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
+		var opts htmlOptions
+		if len(args) == 3 {
+			goVal, err := jsValueToGo(args[2], reflect.TypeOf(htmlOptions{}), fileStates[f].strict)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = goVal.Elem().Interface().(htmlOptions)
+		}
+		var coordinates []int
+		if args[1].String() != "" {
+			if coordinates, err = rangeRefToCoordinates(args[1].String()); err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+		}
+		r := &htmlRenderer{f: f, sheet: args[0].String(), opts: opts, styles: map[int]string{}}
+		if ret["html"], err = r.render(coordinates); err != nil {
+			ret["html"] = ""
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// ProtectWorkbook provides a function to prevent other users from viewing
-// hidden worksheets, adding, moving, deleting, or hiding worksheets, and
-// renaming worksheets in a workbook. The optional field AlgorithmName
-// specified hash algorithm, support XOR, MD4, MD5, SHA-1, SHA2-56, SHA-384,
-// and SHA-512 currently, if no hash algorithm specified, will be using the XOR
-// algorithm as default. The generated workbook only works on Microsoft Office
-// 2007 and later.
-func ProtectWorkbook(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// htmlOptions directly maps the options for rendering the HTML table, the
+// ShowHidden specifies whether to render the hidden rows and columns.
+type htmlOptions struct {
+	ShowHidden bool
+}
+
+// htmlRenderer renders the cells of a worksheet as an HTML table, it caches
+// the CSS declarations of each style.
+type htmlRenderer struct {
+	f      *excelize.File
+	sheet  string
+	opts   htmlOptions
+	rows   []htmlRow
+	styles map[int]string
+}
+
+// htmlRow defined the properties of a row in the worksheet data and the number
+// of the cells in the row until the last cell with value.
+type htmlRow struct {
+	cells int
+	opts  excelize.RowOpts
+}
+
+// htmlMergeCell defined the rendering state of a merged cell, the cell is the
+// top-left cell of the merged cell which provides the value and style.
+type htmlMergeCell struct {
+	cell             string
+	colSpan, rowSpan int
+}
+
+var (
+	// htmlBorderStyles defined the CSS border declarations of the border
+	// styles, the index of the slice is the border style ID.
+	htmlBorderStyles = []string{
+		"", "1px solid", "2px solid", "1px dashed", "1px dotted", "3px solid", "3px double", "1px dotted",
+		"2px dashed", "1px dashed", "2px dashed", "1px dotted", "2px dotted", "2px dashed",
+	}
+	// htmlHorizontalAligns defined the CSS text alignments of the horizontal
+	// alignments.
+	htmlHorizontalAligns = map[string]string{
+		"left": "left", "center": "center", "right": "right", "fill": "left",
+		"justify": "justify", "centerContinuous": "center", "distributed": "justify",
+	}
+	// htmlVerticalAligns defined the CSS vertical alignments of the vertical
+	// alignments.
+	htmlVerticalAligns = map[string]string{
+		"top": "top", "center": "middle", "bottom": "bottom", "justify": "middle", "distributed": "middle",
+	}
+	// htmlLinkSchemes defined the schemes of the hyperlinks which will be
+	// rendered as links to external resources.
+	htmlLinkSchemes = []string{"http://", "https://", "ftp://", "mailto:"}
+)
+
+// render returns the HTML table of the cells in the range by given
+// coordinates, the used range of the worksheet will be rendered if the
+// coordinates is nil.
+func (r *htmlRenderer) render(coordinates []int) (string, error) {
+	if coordinates == nil {
+		rows, err := r.f.GetRows(r.sheet)
+		if err != nil {
+			return "", err
+		}
+		coordinates = []int{1, 1, 1, max(len(rows), 1)}
+		for _, row := range rows {
+			coordinates[2] = max(coordinates[2], len(row))
 		}
-		var opts excelize.WorkbookProtectionOptions
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.WorkbookProtectionOptions{}))
+	}
+	if err := r.loadRows(); err != nil {
+		return "", err
+	}
+	var (
+		cols, rowNums []int
+		width         float64
+		sb, colGroup  strings.Builder
+	)
+	for col := coordinates[0]; col <= coordinates[2]; col++ {
+		name, err := excelize.ColumnNumberToName(col)
 		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+			return "", err
 		}
-		opts = goVal.Elem().Interface().(excelize.WorkbookProtectionOptions)
-		if err := f.ProtectWorkbook(&opts); err != nil {
-			ret["error"] = err.Error()
+		visible, err := r.f.GetColVisible(r.sheet, name)
+		if err != nil {
+			return "", err
 		}
-		return js.ValueOf(ret)
+		if !visible && !r.opts.ShowHidden {
+			continue
+		}
+		colWidth, err := r.f.GetColWidth(r.sheet, name)
+		if err != nil {
+			return "", err
+		}
+		px := math.Round(colWidth*7 + 5)
+		cols, width = append(cols, col), width+px
+		colGroup.WriteString(`<col style="width:` + formatCSSNumber(px) + `px">`)
 	}
-}
-
-// RemoveCol provides a function to remove single column by given worksheet
-// name and column index.
-//
-// Use this method with caution, which will affect changes in references such
-// as formulas, charts, and so on. If there is any referenced value of the
-// worksheet, it will cause a file error when you open it. The excelize only
-// partially updates these references currently.
-func RemoveCol(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+	for row := coordinates[1]; row <= coordinates[3]; row++ {
+		// The rows after the worksheet data have no row properties, which are
+		// visible
+		if row > len(r.rows) || !r.rows[row-1].opts.Hidden || r.opts.ShowHidden {
+			rowNums = append(rowNums, row)
 		}
-		if err := f.RemoveCol(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+	}
+	mergeCells, covered, err := r.mergeCells(coordinates, cols, rowNums)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(`<table style="border-collapse:collapse;table-layout:fixed;width:` + formatCSSNumber(width) + `px">`)
+	sb.WriteString("<colgroup>" + colGroup.String() + "</colgroup><tbody>")
+	for _, row := range rowNums {
+		height, err := r.f.GetRowHeight(r.sheet, row)
+		if err != nil {
+			return "", err
 		}
-		return js.ValueOf(ret)
+		sb.WriteString(`<tr style="height:` + formatCSSNumber(height) + `pt">`)
+		for _, col := range cols {
+			if covered[[2]int{col, row}] {
+				continue
+			}
+			cell, err := excelize.CoordinatesToCellName(col, row)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString("<td")
+			if mergeCell, ok := mergeCells[[2]int{col, row}]; ok {
+				cell = mergeCell.cell
+				if mergeCell.colSpan > 1 {
+					sb.WriteString(` colspan="` + strconv.Itoa(mergeCell.colSpan) + `"`)
+				}
+				if mergeCell.rowSpan > 1 {
+					sb.WriteString(` rowspan="` + strconv.Itoa(mergeCell.rowSpan) + `"`)
+				}
+			}
+			if err = r.renderCell(&sb, cell); err != nil {
+				return "", err
+			}
+		}
+		sb.WriteString("</tr>")
 	}
+	sb.WriteString("</tbody></table>")
+	return sb.String(), nil
 }
 
-// RemovePageBreak remove a page break by given worksheet name and cell
-// reference.
-func RemovePageBreak(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if err := f.RemovePageBreak(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+// loadRows reads the properties and the number of cells of each row in the
+// worksheet data with the rows iterator, so the visibility and styles of the
+// cells can be got without preparing the rows and cells which not exist in
+// the worksheet.
+func (r *htmlRenderer) loadRows() error {
+	// Read the worksheet to make the rows of the worksheet data contiguous
+	if _, err := r.f.GetRowVisible(r.sheet, 1); err != nil {
+		return err
+	}
+	rows, err := r.f.Rows(r.sheet)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		opts := rows.GetRowOpts()
+		cells, err := rows.Columns(excelize.Options{RawCellValue: true})
+		if err != nil {
+			_ = rows.Close()
+			return err
 		}
-		return js.ValueOf(ret)
+		r.rows = append(r.rows, htmlRow{cells: len(cells), opts: opts})
 	}
+	return rows.Close()
 }
 
-// RemoveRow provides a function to remove single row by given worksheet name
-// and Excel row number.
-//
-// Use this method with caution, which will affect changes in references such
-// as formulas, charts, and so on. If there is any referenced value of the
-// worksheet, it will cause a file error when you open it. The excelize only
-// partially updates these references currently.
-func RemoveRow(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// mergeCells returns the merged cells by the coordinates of the first visible
+// cell in the merged cells which clipped by the range, and the coordinates of
+// the other cells covered by the merged cells.
+func (r *htmlRenderer) mergeCells(coordinates, cols, rows []int) (map[[2]int]htmlMergeCell, map[[2]int]bool, error) {
+	mergeCells, covered := map[[2]int]htmlMergeCell{}, map[[2]int]bool{}
+	cells, err := r.f.GetMergeCells(r.sheet, true)
+	if err != nil {
+		return mergeCells, covered, err
+	}
+	inRange := func(v, lo, hi int) bool { return lo <= v && v <= hi }
+	for _, cell := range cells {
+		ref, err := rangeRefToCoordinates(cell.GetStartAxis() + ":" + cell.GetEndAxis())
+		if err != nil {
+			return mergeCells, covered, err
 		}
-		if err := f.RemoveRow(args[0].String(), args[1].Int()); err != nil {
-			ret["error"] = err.Error()
+		x1, y1 := max(ref[0], coordinates[0]), max(ref[1], coordinates[1])
+		x2, y2 := min(ref[2], coordinates[2]), min(ref[3], coordinates[3])
+		if x1 > x2 || y1 > y2 {
+			continue
 		}
-		return js.ValueOf(ret)
+		var spanCols, spanRows []int
+		for _, col := range cols {
+			if inRange(col, x1, x2) {
+				spanCols = append(spanCols, col)
+			}
+		}
+		for _, row := range rows {
+			if inRange(row, y1, y2) {
+				spanRows = append(spanRows, row)
+			}
+		}
+		if len(spanCols) == 0 || len(spanRows) == 0 {
+			continue
+		}
+		for _, col := range spanCols {
+			for _, row := range spanRows {
+				covered[[2]int{col, row}] = true
+			}
+		}
+		anchor := [2]int{spanCols[0], spanRows[0]}
+		delete(covered, anchor)
+		mergeCells[anchor] = htmlMergeCell{cell: cell.GetStartAxis(), colSpan: len(spanCols), rowSpan: len(spanRows)}
 	}
+	return mergeCells, covered, nil
 }
 
-// SearchSheet provides a function to get cell reference by given worksheet
-// name, cell value, and regular expression. The function doesn't support
-// searching on the calculated result, formatted numbers and conditional
-// lookup currently. If it is a merged cell, it will return the cell reference
-// of the upper left cell of the merged range reference.
-func SearchSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"result": js.ValueOf([]interface{}{}), "error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeBoolean}, opts: true},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var reg bool
-		if len(args) == 3 {
-			reg = args[2].Bool()
+// renderCell writes the style attribute and the content of the table cell by
+// given cell reference, and closes the table cell.
+func (r *htmlRenderer) renderCell(sb *strings.Builder, cell string) error {
+	value, err := r.f.GetCellValue(r.sheet, cell)
+	if err != nil {
+		return err
+	}
+	cellType, err := r.f.GetCellType(r.sheet, cell)
+	if err != nil {
+		return err
+	}
+	css, err := r.cellCSS(cell)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(css, "text-align:") {
+		raw, err := r.f.GetCellValue(r.sheet, cell, excelize.Options{RawCellValue: true})
+		if err != nil {
+			return err
+		}
+		switch cellType {
+		case excelize.CellTypeBool, excelize.CellTypeError:
+			css += "text-align:center;"
+		case excelize.CellTypeUnset, excelize.CellTypeNumber, excelize.CellTypeDate:
+			if _, err := strconv.ParseFloat(raw, 64); err == nil || cellType == excelize.CellTypeDate {
+				css += "text-align:right;"
+			}
 		}
-		result, err := f.SearchSheet(args[0].String(), args[1].String(), reg)
+	}
+	sb.WriteString(` style="` + html.EscapeString(css) + `">`)
+	content := html.EscapeString(value)
+	if cellType == excelize.CellTypeSharedString || cellType == excelize.CellTypeInlineString {
+		runs, err := r.f.GetCellRichText(r.sheet, cell)
 		if err != nil {
-			ret["error"] = err.Error()
+			return err
 		}
-		excepted := make([]interface{}, len(result))
-		for i, cell := range result {
-			excepted[i] = cell
+		if slices.ContainsFunc(runs, func(run excelize.RichTextRun) bool { return run.Font != nil }) {
+			content = ""
+			for _, run := range runs {
+				content += `<span style="` + html.EscapeString(r.fontCSS(run.Font)) + `">` + html.EscapeString(run.Text) + "</span>"
+			}
 		}
-		ret["result"] = excepted
-		return js.ValueOf(ret)
 	}
-}
-
-// SetActiveSheet provides a function to set the default active sheet of the
-// workbook by a given index. Note that the active index is different from the
-// ID returned by function GetSheetMap(). It should be greater than or equal
-// to 0 and less than the total worksheet numbers.
-func SetActiveSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+	ok, target, err := r.f.GetCellHyperLink(r.sheet, cell)
+	if err != nil {
+		return err
+	}
+	if ok && target != "" {
+		href, attrs := "#"+target, ""
+		for _, scheme := range htmlLinkSchemes {
+			if strings.HasPrefix(strings.ToLower(target), scheme) {
+				href, attrs = target, ` target="_blank" rel="noopener noreferrer"`
+				break
+			}
 		}
-		f.SetActiveSheet(args[0].Int())
-		return js.ValueOf(ret)
+		content = `<a href="` + html.EscapeString(href) + `"` + attrs + ">" + content + "</a>"
 	}
+	sb.WriteString(content + "</td>")
+	return nil
 }
 
-// SetAppProps provides a function to set document application properties.
-func SetAppProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// cellCSS returns the CSS declarations of the style of the cell by given cell
+// reference.
+func (r *htmlRenderer) cellCSS(cell string) (string, error) {
+	styleID, err := r.cellStyle(cell)
+	if err != nil {
+		return "", err
+	}
+	if css, ok := r.styles[styleID]; ok {
+		return css, nil
+	}
+	style, err := r.f.GetStyle(styleID)
+	if err != nil {
+		return "", err
+	}
+	css := r.fontCSS(style.Font)
+	if len(style.Fill.Color) > 0 && (style.Fill.Type == "gradient" || style.Fill.Pattern > 0) {
+		if color := r.color(style.Fill.Color[0], 0, nil); color != "" {
+			css += "background-color:" + color + ";"
 		}
-		var props excelize.AppProperties
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.AppProperties{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+	}
+	for _, border := range style.Border {
+		if border.Style <= 0 || border.Style >= len(htmlBorderStyles) || inStrSlice([]string{"left", "right", "top", "bottom"}, border.Type) == -1 {
+			continue
 		}
-		props = goVal.Elem().Interface().(excelize.AppProperties)
-		if err := f.SetAppProps(&props); err != nil {
-			ret["error"] = err.Error()
+		color := r.color(border.Color, 0, nil)
+		if color == "" {
+			color = "#000000"
 		}
-		return js.ValueOf(ret)
+		css += "border-" + border.Type + ":" + htmlBorderStyles[border.Style] + " " + color + ";"
 	}
+	verticalAlign, whiteSpace := "bottom", "pre;overflow:hidden"
+	if alignment := style.Alignment; alignment != nil {
+		if align, ok := htmlHorizontalAligns[alignment.Horizontal]; ok {
+			css += "text-align:" + align + ";"
+		}
+		if align, ok := htmlVerticalAligns[alignment.Vertical]; ok {
+			verticalAlign = align
+		}
+		if alignment.WrapText {
+			whiteSpace = "pre-wrap;overflow-wrap:break-word"
+		}
+		if alignment.Indent > 0 {
+			padding := "padding-left:"
+			if alignment.Horizontal == "right" {
+				padding = "padding-right:"
+			}
+			css += padding + strconv.Itoa(alignment.Indent*9) + "px;"
+		}
+	}
+	css += "vertical-align:" + verticalAlign + ";white-space:" + whiteSpace + ";"
+	r.styles[styleID] = css
+	return css, nil
 }
 
-// SetCalcProps provides a function to sets calculation properties. Optional
-// value of "CalcMode" property is: "manual", "auto" or "autoNoTable". Optional
-// value of "RefMode" property is: "A1" or "R1C1".
-func SetCalcProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.CalcPropsOptions
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.CalcPropsOptions{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// cellStyle returns the style ID of the cell by given cell reference, the style
+// of the row or column will be used for the cell after the last cell with value
+// in the row.
+func (r *htmlRenderer) cellStyle(cell string) (int, error) {
+	col, row, err := excelize.CellNameToCoordinates(cell)
+	if err != nil {
+		return 0, err
+	}
+	if row <= len(r.rows) {
+		if col <= r.rows[row-1].cells {
+			return r.f.GetCellStyle(r.sheet, cell)
 		}
-		opts = goVal.Elem().Interface().(excelize.CalcPropsOptions)
-		if err := f.SetCalcProps(&opts); err != nil {
-			ret["error"] = err.Error()
+		if styleID := r.rows[row-1].opts.StyleID; styleID != 0 {
+			return styleID, nil
 		}
-		return js.ValueOf(ret)
 	}
+	name, err := excelize.ColumnNumberToName(col)
+	if err != nil {
+		return 0, err
+	}
+	return r.f.GetColStyle(r.sheet, name)
 }
 
-// SetCellBool provides a function to set bool type value of a cell by given
-// worksheet name, cell reference and cell value.
-func SetCellBool(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeBoolean}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// fontCSS returns the CSS declarations of the font.
+func (r *htmlRenderer) fontCSS(font *excelize.Font) string {
+	if font == nil {
+		return ""
+	}
+	var css string
+	if font.Family != "" {
+		css += "font-family:" + strconv.Quote(font.Family) + ";"
+	}
+	if font.Size > 0 {
+		css += "font-size:" + formatCSSNumber(font.Size) + "pt;"
+	}
+	if font.Bold {
+		css += "font-weight:bold;"
+	}
+	if font.Italic {
+		css += "font-style:italic;"
+	}
+	var decorations []string
+	if font.Underline != "" && font.Underline != "none" {
+		decorations = append(decorations, "underline")
+		if strings.HasPrefix(font.Underline, "double") {
+			decorations = append(decorations, "double")
 		}
-		if err := f.SetCellBool(args[0].String(), args[1].String(), args[2].Bool()); err != nil {
-			ret["error"] = err.Error()
+	}
+	if font.Strike {
+		decorations = append(decorations, "line-through")
+	}
+	if len(decorations) > 0 {
+		css += "text-decoration:" + strings.Join(decorations, " ") + ";"
+	}
+	switch font.VertAlign {
+	case "superscript":
+		css += "vertical-align:super;"
+	case "subscript":
+		css += "vertical-align:sub;"
+	}
+	if font.Color != "" || font.ColorIndexed != 0 || font.ColorTheme != nil {
+		if color := r.color(font.Color, font.ColorIndexed, font.ColorTheme); color != "" {
+			css += "color:" + color + ";"
 		}
-		return js.ValueOf(ret)
 	}
+	return css
 }
 
-// SetCellDefault provides a function to set string type value of a cell as
-// default format without escaping the cell.
-func SetCellDefault(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// color returns the CSS hex color by given hex color code, indexed color and
+// theme color, it returns an empty string if the color is invalid.
+func (r *htmlRenderer) color(hexColor string, indexedColor int, themeColor *int) string {
+	color := r.f.GetBaseColor(strings.TrimPrefix(hexColor, "#"), indexedColor, themeColor)
+	if _, err := strconv.ParseUint(color, 16, 32); err != nil || len(color) != 6 {
+		return ""
+	}
+	return "#" + strings.ToUpper(color)
+}
+
+// formatCSSNumber returns the string of the number in CSS declarations.
+func formatCSSNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// Rows returns a rows iterator, used for streaming reading data for a
+// worksheet with a large data. This function is concurrency safe. The
+// returned object can also be iterated by the "for await...of" statement,
+// which yields the columns of each row. Note that you must call the 'Close'
+// function of the iterator to close the stream if not iterating to the end.
+func Rows(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
+		fn := map[string]interface{}{"error": nil}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+			setError(fn, args, err)
+			return js.ValueOf(fn)
 		}
-		if err := f.SetCellDefault(args[0].String(), args[1].String(), args[2].String()); err != nil {
-			ret["error"] = err.Error()
+		rows, err := f.Rows(args[0].String())
+		if err != nil {
+			setError(fn, args, err)
+			return js.ValueOf(fn)
 		}
-		return js.ValueOf(ret)
+		return regRowsFunc(f, rows, fn)
 	}
 }
 
-// SetCellFloat sets a floating point value into a cell. The precision
-// parameter specifies how many places after the decimal will be shown
-// while -1 is a special value that will use as many decimal places as
-// necessary to represent the number. bitSize is 32 or 64 depending on if a
-// float32 or float64 was originally used for the value.
-func SetCellFloat(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// RowsClose closes the open worksheet XML file in the system temporary
+// directory.
+func RowsClose(rows *excelize.Rows) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
+		if err := prepareArgs(args, []argsRule{}); err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetCellFloat(args[0].String(), args[1].String(), args[2].Float(), args[3].Int(), args[4].Int()); err != nil {
-			ret["error"] = err.Error()
+		if err := rows.Close(); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetCellFormula provides a function to set formula on the cell is taken
-// according to the given worksheet name and cell formula settings. The result
-// of the formula cell can be calculated when the worksheet is opened by the
-// Office Excel application or can be using the "CalcCellValue" function also
-// can get the calculated cell value. If the Excel application doesn't
-// calculate the formula automatically when the workbook has been opened,
-// please call "UpdateLinkedValue" after setting the cell formula functions.
-func SetCellFormula(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// RowsColumns return the current row's column values. This fetches the
+// worksheet data as a stream, returns each cell in a row as is, and will not
+// skip empty rows in the tail of the worksheet.
+func RowsColumns(rows *excelize.Rows, strict bool) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
+		ret := map[string]interface{}{"result": []interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}, opts: true},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var opts excelize.FormulaOpts
-		if len(args) == 4 {
-			goVal, err := jsValueToGo(args[3], reflect.TypeOf(excelize.FormulaOpts{}))
+		var opts excelize.Options
+		if len(args) == 1 {
+			goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Options{}), strict)
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
-			opts = goVal.Elem().Interface().(excelize.FormulaOpts)
+			opts = goVal.Elem().Interface().(excelize.Options)
 		}
-		if err := f.SetCellFormula(args[0].String(), args[1].String(), args[2].String(), opts); err != nil {
-			ret["error"] = err.Error()
+		columns, err := rows.Columns(opts)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		result := make([]interface{}, len(columns))
+		for i, cell := range columns {
+			result[i] = cell
 		}
+		ret["result"] = result
 		return js.ValueOf(ret)
 	}
 }
 
-// SetCellHyperLink provides a function to set cell hyperlink by given
-// worksheet name and link URL address. LinkType defines three types of
-// hyperlink "External" for website or "Location" for moving to one of cell in
-// this workbook or "None" for remove hyperlink. Maximum limit hyperlinks in a
-// worksheet is 65530. This function is only used to set the hyperlink of the
-// cell and doesn't affect the value of the cell. If you need to set the value
-// of the cell, please use the other functions such as `SetCellStyle` or
-// `SetSheetRow`.
-func SetCellHyperLink(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// RowsError will return the error when the error occurs.
+func RowsError(rows *excelize.Rows) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}, opts: true},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.HyperlinkOpts
-		if len(args) == 5 {
-			goVal, err := jsValueToGo(args[4], reflect.TypeOf(excelize.HyperlinkOpts{}))
-			if err != nil {
-				ret["error"] = err.Error()
-				return js.ValueOf(ret)
-			}
-			opts = goVal.Elem().Interface().(excelize.HyperlinkOpts)
+		if err := rows.Error(); err != nil {
+			setError(ret, args, err)
 		}
-		if err := f.SetCellHyperLink(args[0].String(), args[1].String(), args[2].String(), args[3].String(), opts); err != nil {
-			ret["error"] = err.Error()
+		return js.ValueOf(ret)
+	}
+}
+
+// RowsGetRowOpts will return the RowOpts of the current row.
+func RowsGetRowOpts(rows *excelize.Rows, bigInt bool) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"opts": map[string]interface{}{}, "error": nil}
+		jsVal, err := goValueToJS(reflect.ValueOf(rows.GetRowOpts()),
+			reflect.TypeOf(excelize.RowOpts{}), bigInt)
+		if err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
 		}
+		ret["opts"] = jsVal
 		return js.ValueOf(ret)
 	}
 }
 
-// SetCellInt provides a function to set int type value of a cell by given
-// worksheet name, cell reference and cell value.
-func SetCellInt(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// RowsNext will return true if find the next row element.
+func RowsNext(rows *excelize.Rows) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
+		return js.ValueOf(rows.Next())
+	}
+}
+
+// SearchSheet provides a function to get cell reference by given worksheet
+// name, cell value, and regular expression. The function doesn't support
+// searching on the calculated result, formatted numbers and conditional
+// lookup currently. If it is a merged cell, it will return the cell reference
+// of the upper left cell of the merged range reference.
+func SearchSheet(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		ret := map[string]interface{}{"result": js.ValueOf([]interface{}{}), "error": nil}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeBoolean}, opts: true},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetCellInt(args[0].String(), args[1].String(), int64(args[2].Int())); err != nil {
-			ret["error"] = err.Error()
+		var reg bool
+		if len(args) == 3 {
+			reg = args[2].Bool()
+		}
+		result, err := f.SearchSheet(args[0].String(), args[1].String(), reg)
+		if err != nil {
+			setError(ret, args, err)
 		}
+		excepted := make([]interface{}, len(result))
+		for i, cell := range result {
+			excepted[i] = cell
+		}
+		ret["result"] = excepted
 		return js.ValueOf(ret)
 	}
 }
@@ -3567,64 +6219,20 @@ func SetCellRichText(f *excelize.File) func(this js.Value, args []js.Value) inte
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var runs []excelize.RichTextRun
 		for i := 0; i < args[2].Length(); i++ {
-			goVal, err := jsValueToGo(args[2].Index(i), reflect.TypeOf(excelize.RichTextRun{}))
+			goVal, err := jsValueToGo(args[2].Index(i), reflect.TypeOf(excelize.RichTextRun{}), fileStates[f].strict)
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			runs = append(runs, goVal.Elem().Interface().(excelize.RichTextRun))
 		}
 		if err := f.SetCellRichText(args[0].String(), args[1].String(), runs); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetCellStr provides a function to set string type value of a cell. Total
-// number of characters that a cell can contain 32767 characters.
-func SetCellStr(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if err := f.SetCellStr(args[0].String(), args[1].String(), args[2].String()); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetCellStyle provides a function to add style attribute for cells by given
-// worksheet name, range reference and style ID. Note that diagonalDown and
-// diagonalUp type border should be use same color in the same range.
-// SetCellStyle will overwrite the existing styles for the cell, it won't
-// append or merge style with existing styles.
-func SetCellStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if err := f.SetCellStyle(args[0].String(), args[1].String(), args[2].String(), args[3].Int()); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -3644,109 +6252,158 @@ func SetCellValue(f *excelize.File) func(this js.Value, args []js.Value) interfa
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeBoolean, js.TypeNumber, js.TypeString}},
+			{types: []js.Type{js.TypeBoolean, js.TypeNumber, jsTypeBigInt, js.TypeString, js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var value interface{}
-		switch args[2].Type() {
-		case js.TypeBoolean:
-			value = args[2].Bool()
-		case js.TypeNumber:
-			value = args[2].Float()
-		default: // js.TypeString:
-			value = args[2].String()
+		value := jsValueToCellValue(args[2])
+		if value == nil {
+			setError(ret, args, errArgType)
+			return js.ValueOf(ret)
 		}
 		if err := f.SetCellValue(args[0].String(), args[1].String(), value); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetColOutlineLevel provides a function to set outline level of a single
-// column by given worksheet name and column name. The value of parameter
-// 'level' is 1-7.
-func SetColOutlineLevel(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// SetCells provides a function to set the values, formulas and styles of
+// multiple cells in one call by given worksheet name and cell entries. The
+// entries could be an array of the records with the cell reference, an
+// object keyed by the cell reference, or a 2D block of the values anchored at
+// the given top-left cell. Each entry could be a cell value or a record with
+// value, formula and style ID. The failing entries will not stop setting the
+// other entries, and the errors of them will be returned with the cell
+// reference.
+func SetCells(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
+		ret := map[string]interface{}{"errors": []interface{}{}, "error": nil}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeString, js.TypeObject}},
+			{types: []js.Type{js.TypeObject}, opts: true},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetColOutlineLevel(args[0].String(), args[1].String(), uint8(args[2].Int())); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetColStyle provides a function to set style of columns by given worksheet
-// name, columns range and style ID. Note that this will overwrite the
-// existing styles for the columns, it won't append or merge style with
-// existing styles.
-func SetColStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
+		sheet := args[0].String()
+		if idx, err := f.GetSheetIndex(sheet); err != nil || idx == -1 {
+			if err == nil {
+				err = excelize.ErrSheetNotExist{SheetName: sheet}
+			}
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetColStyle(args[0].String(), args[1].String(), args[2].Int()); err != nil {
-			ret["error"] = err.Error()
+		type cellEntry struct {
+			path, cell string
+			value      js.Value
 		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetColVisible provides a function to set visible columns by given worksheet
-// name, columns range and visibility.
-func SetColVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeBoolean}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+		var errs []interface{}
+		argIdx := 1
+		setEntryError := func(entry cellEntry, err error) {
+			errs = append(errs, map[string]interface{}{
+				"cell":      entry.cell,
+				"error":     err.Error(),
+				"errorInfo": newErrorInfo(args, withArgPath(err, entry.path, args[argIdx])),
+			})
+		}
+		setEntry := func(entry cellEntry) {
+			if err := setCellEntry(f, sheet, entry.cell, entry.value); err != nil {
+				setEntryError(entry, err)
+			}
+		}
+		switch {
+		case jsType(args[1]) == js.TypeString:
+			if len(args) != 3 {
+				setError(ret, args, errArgNum)
+				return js.ValueOf(ret)
+			}
+			col, row, err := excelize.CellNameToCoordinates(args[1].String())
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			argIdx = 2
+			for r := 0; r < args[2].Length(); r++ {
+				values := args[2].Index(r)
+				if !js.Global().Get("Array").Call("isArray", values).Bool() {
+					setError(ret, args, &argError{err: errArgType, index: 2})
+					return js.ValueOf(ret)
+				}
+				for c := 0; c < values.Length(); c++ {
+					cell, err := excelize.CoordinatesToCellName(col+c, row+r)
+					entry := cellEntry{path: "[" + strconv.Itoa(r) + "][" + strconv.Itoa(c) + "]", cell: cell, value: values.Index(c)}
+					if err != nil {
+						setEntryError(entry, err)
+						continue
+					}
+					setEntry(entry)
+				}
+			}
+		case len(args) == 3:
+			setError(ret, args, errArgNum)
+			return js.ValueOf(ret)
+		case js.Global().Get("Array").Call("isArray", args[1]).Bool():
+			for i := 0; i < args[1].Length(); i++ {
+				record := args[1].Index(i)
+				entry := cellEntry{path: "[" + strconv.Itoa(i) + "]", value: record}
+				if jsType(record) != js.TypeObject || isJSDate(record) || jsType(record.Get("Cell")) != js.TypeString {
+					setEntryError(entry, withArgPath(errArgType, "Cell", record))
+					continue
+				}
+				entry.cell = record.Get("Cell").String()
+				setEntry(entry)
+			}
+		default:
+			keys := js.Global().Get("Object").Call("keys", args[1])
+			for i := 0; i < keys.Length(); i++ {
+				cell := keys.Index(i).String()
+				setEntry(cellEntry{path: cell, cell: cell, value: args[1].Get(cell)})
+			}
 		}
-		if err := f.SetColVisible(args[0].String(), args[1].String(), args[2].Bool()); err != nil {
-			ret["error"] = err.Error()
+		if errs != nil {
+			ret["errors"] = errs
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetColWidth provides a function to set the width of a single column or
-// multiple columns.
-func SetColWidth(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// setCellEntry provides a function to set the cell by given worksheet name,
+// cell reference and the entry of the SetCells function. The entry could be a
+// cell value or a record with value, formula and style ID.
+func setCellEntry(f *excelize.File, sheet, cell string, entry js.Value) error {
+	if jsType(entry) != js.TypeObject || isJSDate(entry) {
+		value := jsValueToCellValue(entry)
+		if value == nil && !entry.IsNull() {
+			return errArgType
 		}
-		if err := f.SetColWidth(args[0].String(), args[1].String(), args[2].String(), args[3].Float()); err != nil {
-			ret["error"] = err.Error()
+		return f.SetCellValue(sheet, cell, value)
+	}
+	goVal, err := jsValueToGo(entry, reflect.TypeOf(excelize.Cell{}), fileStates[f].strict, "Cell")
+	if err != nil {
+		return err
+	}
+	record := goVal.Elem().Interface().(excelize.Cell)
+	if value := entry.Get("Value"); !value.IsUndefined() {
+		cellValue := jsValueToCellValue(value)
+		if cellValue == nil && !value.IsNull() {
+			return withArgPath(errArgType, "Value", entry)
+		}
+		if err = f.SetCellValue(sheet, cell, cellValue); err != nil {
+			return err
+		}
+	}
+	if record.Formula != "" {
+		if err = f.SetCellFormula(sheet, cell, record.Formula); err != nil {
+			return err
 		}
-		return js.ValueOf(ret)
 	}
+	if !entry.Get("StyleID").IsUndefined() {
+		return f.SetCellStyle(sheet, cell, cell, record.StyleID)
+	}
+	return nil
 }
 
 // SetConditionalFormat provides a function to create conditional formatting
@@ -3761,20 +6418,20 @@ func SetConditionalFormat(f *excelize.File) func(this js.Value, args []js.Value)
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var opts []excelize.ConditionalFormatOptions
 		for i := 0; i < args[2].Length(); i++ {
-			goVal, err := jsValueToGo(args[2].Index(i), reflect.TypeOf(excelize.ConditionalFormatOptions{}))
+			goVal, err := jsValueToGo(args[2].Index(i), reflect.TypeOf(excelize.ConditionalFormatOptions{}), fileStates[f].strict)
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			opts = append(opts, goVal.Elem().Interface().(excelize.ConditionalFormatOptions))
 		}
 		if err := f.SetConditionalFormat(args[0].String(), args[1].String(), opts); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -3792,70 +6449,33 @@ func SetCustomProps(f *excelize.File) func(this js.Value, args []js.Value) inter
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if _, err := jsValueToGo(args[0], reflect.TypeOf(excelize.CustomProperty{})); err != nil {
-			ret["error"] = err.Error()
+		if _, err := jsValueToGo(args[0], reflect.TypeOf(excelize.CustomProperty{}), fileStates[f].strict); err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		prop := excelize.CustomProperty{Name: args[0].Get("Name").String()}
 		val := args[0].Get("Value")
-		switch val.Type() {
+		switch jsType(val) {
 		case js.TypeNull:
 			prop.Value = nil
 		case js.TypeBoolean:
 			prop.Value = val.Bool()
 		case js.TypeNumber:
 			prop.Value = val.Float()
-		default: // js.TypeString:
+		case js.TypeString:
 			prop.Value = val.String()
+		default:
+			if !isJSDate(val) {
+				setError(ret, args, errArgType)
+				return js.ValueOf(ret)
+			}
+			prop.Value = time.UnixMilli(int64(val.Call("getTime").Float())).UTC()
 		}
 		if err := f.SetCustomProps(prop); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetDefaultFont changes the default font in the workbook.
-func SetDefaultFont(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{{types: []js.Type{js.TypeString}}}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if err := f.SetDefaultFont(args[0].String()); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetDefinedName provides a function to set the defined names of the workbook
-// or worksheet. If not specified scope, the default scope is workbook.
-func SetDefinedName(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeObject}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var definedName excelize.DefinedName
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.DefinedName{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		definedName = goVal.Elem().Interface().(excelize.DefinedName)
-		if err = f.SetDefinedName(&definedName); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		return js.ValueOf(ret)
@@ -3870,214 +6490,216 @@ func SetDocProps(f *excelize.File) func(this js.Value, args []js.Value) interfac
 			{types: []js.Type{js.TypeObject}},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		var props excelize.DocProperties
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.DocProperties{}))
+		obj := args[0]
+		if isJSDate(obj.Get("Created")) || isJSDate(obj.Get("Modified")) {
+			obj = js.Global().Get("Object").Call("assign", js.Global().Get("Object").New(), args[0])
+			for _, name := range []string{"Created", "Modified"} {
+				if val := obj.Get(name); isJSDate(val) {
+					obj.Set(name, time.UnixMilli(int64(val.Call("getTime").Float())).UTC().Format(time.RFC3339))
+				}
+			}
+		}
+		goVal, err := jsValueToGo(obj, reflect.TypeOf(excelize.DocProperties{}), fileStates[f].strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		props = goVal.Elem().Interface().(excelize.DocProperties)
 		if err = f.SetDocProps(&props); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetHeaderFooter provides a function to set headers and footers by given
-// worksheet name and the control characters.
-func SetHeaderFooter(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.HeaderFooterOptions
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.HeaderFooterOptions{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		opts = goVal.Elem().Interface().(excelize.HeaderFooterOptions)
-		if err = f.SetHeaderFooter(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetPageLayout provides a function to sets worksheet page layout.
-func SetPageLayout(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.PageLayoutOptions
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.PageLayoutOptions{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		opts = goVal.Elem().Interface().(excelize.PageLayoutOptions)
-		if err = f.SetPageLayout(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetPageMargins provides a function to set worksheet page margins.
-func SetPageMargins(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.PageLayoutMarginsOptions
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.PageLayoutMarginsOptions{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		opts = goVal.Elem().Interface().(excelize.PageLayoutMarginsOptions)
-		if err = f.SetPageMargins(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetPanes provides a function to create and remove freeze panes and split
-// panes by given worksheet name and panes format set.
-func SetPanes(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var panes excelize.Panes
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Panes{}))
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		panes = goVal.Elem().Interface().(excelize.Panes)
-		if err := f.SetPanes(args[0].String(), &panes); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetRowHeight provides a function to set the height of a single row. If the
-// value of height is 0, will hide the specified row, if the value of height is
-// -1, will unset the custom row height.
-func SetRowHeight(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		if err := f.SetRowHeight(args[0].String(), args[1].Int(), args[2].Float()); err != nil {
-			ret["error"] = err.Error()
-		}
-		return js.ValueOf(ret)
-	}
-}
-
-// SetRowOutlineLevel provides a function to set outline level number of a
-// single row by given worksheet name and Excel row number. The value of
-// parameter 'level' is 1-7.
-func SetRowOutlineLevel(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetRowOutlineLevel(args[0].String(), args[1].Int(), uint8(args[2].Int())); err != nil {
-			ret["error"] = err.Error()
-		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetRowStyle provides a function to set the style of rows by given worksheet
-// name, row range, and style ID. Note that this will overwrite the existing
-// styles for the rows, it won't append or merge style with existing styles.
-func SetRowStyle(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// SetRecords provides a function to write the records into the worksheet by
+// given worksheet name, top-left cell reference, array of the records and the
+// optional records options. The header row will be written with the column
+// names, and the values of the records will be written below the header row
+// with the cell types of the values. The columns are the keys of the records
+// in the order of their first appearance unless the Columns option is
+// specified. The records will be wrapped as a table if the AsTable option is
+// specified, and the range of the table will be set to the written cells.
+func SetRecords(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
+		err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeNumber}},
-		}); err != nil {
-			ret["error"] = err.Error()
+			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+			{types: []js.Type{js.TypeObject}, opts: true},
+		})
+		if err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetRowStyle(args[0].String(), args[1].Int(), args[2].Int(), args[3].Int()); err != nil {
-			ret["error"] = err.Error()
+		if !js.Global().Get("Array").Call("isArray", args[2]).Bool() {
+			setError(ret, args, &argError{err: errArgType, index: 2})
+			return js.ValueOf(ret)
+		}
+		var opts setRecordsOptions
+		if len(args) == 4 {
+			goVal, err := jsValueToGo(args[3], reflect.TypeOf(setRecordsOptions{}), fileStates[f].strict, "NumberFormats")
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = goVal.Elem().Interface().(setRecordsOptions)
+			if err = opts.prepare(args[3].Get("NumberFormats"), 3); err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+		}
+		if err = setRecords(f, args[0].String(), args[1].String(), args[2], &opts); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetRowVisible provides a function to set visible of a single row by given
-// worksheet name and Excel row number.
-func SetRowVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeBoolean}},
-		}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// setRecordsOptions directly maps the options for writing the records. The
+// HeaderStyle specifies the style ID of the header cells, the AsTable
+// specifies the table options, and the NumberFormats specifies the built-in
+// number format ID or the custom number format code of the columns.
+type setRecordsOptions struct {
+	Columns     []string
+	HeaderStyle int
+	AsTable     *excelize.Table
+
+	numFmts map[string]*excelize.Style
+}
+
+// prepare parses the number formats of the columns in the records options,
+// the errors will be reported with the given argument index and the path of
+// the option field.
+func (opts *setRecordsOptions) prepare(numFmts js.Value, index int) error {
+	if numFmts.IsUndefined() {
+		return nil
+	}
+	if jsType(numFmts) != js.TypeObject {
+		return &argError{err: errArgType, index: index, path: "NumberFormats"}
+	}
+	opts.numFmts = make(map[string]*excelize.Style)
+	keys := js.Global().Get("Object").Call("keys", numFmts)
+	for i := 0; i < keys.Length(); i++ {
+		key := keys.Index(i).String()
+		switch numFmt := numFmts.Get(key); jsType(numFmt) {
+		case js.TypeNumber:
+			opts.numFmts[key] = &excelize.Style{NumFmt: numFmt.Int()}
+		case js.TypeString:
+			customNumFmt := numFmt.String()
+			opts.numFmts[key] = &excelize.Style{CustomNumFmt: &customNumFmt}
+		default:
+			return &argError{err: errArgType, index: index, path: "NumberFormats." + key}
+		}
+	}
+	return nil
+}
+
+// setRecords writes the header row and the values of the JavaScript records
+// into the worksheet from the given top-left cell, and applies the header
+// style, the number formats and the table of the options.
+func setRecords(f *excelize.File, sheet, cell string, records js.Value, opts *setRecordsOptions) error {
+	col, row, err := excelize.CellNameToCoordinates(cell)
+	if err != nil {
+		return err
+	}
+	columns, seen := opts.Columns, map[string]bool{}
+	for i := 0; i < records.Length(); i++ {
+		record := records.Index(i)
+		if jsType(record) != js.TypeObject {
+			return &argError{err: errArgType, index: 2, path: "[" + strconv.Itoa(i) + "]"}
 		}
-		if err := f.SetRowVisible(args[0].String(), args[1].Int(), args[2].Bool()); err != nil {
-			ret["error"] = err.Error()
+		if opts.Columns != nil {
+			continue
+		}
+		keys := js.Global().Get("Object").Call("keys", record)
+		for j := 0; j < keys.Length(); j++ {
+			if key := keys.Index(j).String(); !seen[key] {
+				columns, seen[key] = append(columns, key), true
+			}
+		}
+	}
+	for key := range opts.numFmts {
+		if inStrSlice(columns, key) == -1 {
+			return &argError{err: errArgValue, index: 3, path: "NumberFormats." + key}
+		}
+	}
+	if len(columns) == 0 {
+		_, err = f.GetSheetDimension(sheet)
+		return err
+	}
+	cells := func(col1, row1, col2, row2 int) (string, string, error) {
+		topLeft, err := excelize.CoordinatesToCellName(col1, row1)
+		if err != nil {
+			return "", "", err
+		}
+		bottomRight, err := excelize.CoordinatesToCellName(col2, row2)
+		return topLeft, bottomRight, err
+	}
+	if err = f.SetSheetRow(sheet, cell, &columns); err != nil {
+		return err
+	}
+	if opts.HeaderStyle != 0 {
+		topLeft, bottomRight, err := cells(col, row, col+len(columns)-1, row)
+		if err != nil {
+			return err
+		}
+		if err = f.SetCellStyle(sheet, topLeft, bottomRight, opts.HeaderStyle); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < records.Length(); i++ {
+		for j, column := range columns {
+			jsVal := records.Index(i).Get(column)
+			if jsVal.IsUndefined() || jsVal.IsNull() {
+				continue
+			}
+			value := jsValueToCellValue(jsVal)
+			if value == nil {
+				return &argError{err: errArgType, index: 2, path: "[" + strconv.Itoa(i) + "]." + column}
+			}
+			cell, err := excelize.CoordinatesToCellName(col+j, row+i+1)
+			if err != nil {
+				return err
+			}
+			if err = f.SetCellValue(sheet, cell, value); err != nil {
+				return err
+			}
 		}
-		return js.ValueOf(ret)
 	}
+	for j, column := range columns {
+		style, ok := opts.numFmts[column]
+		if !ok || records.Length() == 0 {
+			continue
+		}
+		styleID, err := f.NewStyle(style)
+		if err != nil {
+			return err
+		}
+		topLeft, bottomRight, err := cells(col+j, row+1, col+j, row+records.Length())
+		if err != nil {
+			return err
+		}
+		if err = f.SetCellStyle(sheet, topLeft, bottomRight, styleID); err != nil {
+			return err
+		}
+	}
+	if opts.AsTable == nil {
+		return nil
+	}
+	topLeft, bottomRight, err := cells(col, row, col+len(columns)-1, row+max(records.Length(), 1))
+	if err != nil {
+		return err
+	}
+	table := *opts.AsTable
+	table.Range = topLeft + ":" + bottomRight
+	return f.AddTable(sheet, &table)
 }
 
 // SetSheetBackgroundFromBytes provides a function to set background picture by
@@ -4091,15 +6713,24 @@ func SetSheetBackgroundFromBytes(f *excelize.File) func(this js.Value, args []js
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		buf := make([]byte, args[2].Get("length").Int())
-		js.CopyBytesToGo(buf, args[2])
-		if err := f.SetSheetBackgroundFromBytes(args[0].String(), args[1].String(), buf); err != nil {
-			ret["error"] = err.Error()
+		setSheetBackground := func(buf []byte, err error) interface{} {
+			if err == nil {
+				err = f.SetSheetBackgroundFromBytes(args[0].String(), args[1].String(), buf)
+			}
+			if err != nil {
+				setError(ret, args, err)
+			}
+			return js.ValueOf(ret)
 		}
-		return js.ValueOf(ret)
+		if isJSByteStream(args[2]) {
+			return newPromise(fileStates[f].throwOnError, func() interface{} {
+				return setSheetBackground(readJSBytes(args[2]))
+			})
+		}
+		return setSheetBackground(jsBytesToGo(args[2]))
 	}
 }
 
@@ -4113,240 +6744,240 @@ func SetSheetCol(f *excelize.File) func(this js.Value, args []js.Value) interfac
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		length := args[2].Length()
 		slice := make([]interface{}, length)
 		for i := 0; i < length; i++ {
-			arg := args[2].Index(i)
-			switch arg.Type() {
-			case js.TypeBoolean:
-				slice[i] = arg.Bool()
-			case js.TypeNumber:
-				slice[i] = arg.Float()
-			case js.TypeString:
-				slice[i] = arg.String()
-			default:
-			}
+			slice[i] = jsValueToCellValue(args[2].Index(i))
 		}
 		if err := f.SetSheetCol(args[0].String(), args[1].String(), &slice); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetSheetDimension provides the method to set or remove the used range of the
-// worksheet by a given range reference. It specifies the row and column bounds
-// of used cells in the worksheet. The range reference is set using the A1
-// reference style(e.g., "A1:D5"). Passing an empty range reference will remove
-// the used range of the worksheet.
-func SetSheetDimension(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// SetSheetRow writes an array to row by given worksheet name, starting cell
+// reference and a pointer to array type 'slice'.
+func SetSheetRow(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
 			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetSheetDimension(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		length := args[2].Length()
+		var slice []interface{}
+		for i := 0; i < length; i++ {
+			slice = append(slice, jsValueToCellValue(args[2].Index(i)))
+		}
+		if err := f.SetSheetRow(args[0].String(), args[1].String(), &slice); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetSheetName provides a function to set the worksheet name by given source
-// and target worksheet names. Maximum 31 characters are allowed in sheet
-// title and this function only changes the name of the sheet and will not
-// update the sheet name in the formula or reference associated with the cell.
-// So there may be problem formula error or reference missing.
-func SetSheetName(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// SetSheetVisible provides a function to set worksheet visible by given
+// worksheet name. A workbook must contain at least one visible worksheet. If
+// the given worksheet has been activated, this setting will be invalidated.
+func SetSheetVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeBoolean}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetSheetName(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		if err := f.SetSheetVisible(args[0].String(), args[1].Bool()); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetSheetProps provides a function to set worksheet properties.
-func SetSheetProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// StreamAddTable creates an Excel table for the stream writer using the given
+// cell range and format set. Note that the table must be at least two lines
+// including the header. The header cells must contain strings and must be
+// unique. Currently, only one table is allowed for a stream writer. AddTable
+// must be called after the rows are written but before Flush.
+func StreamAddTable(sw *excelize.StreamWriter, strict bool) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
+		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeObject}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
+		}); err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var opts excelize.SheetPropsOptions
-		goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.SheetPropsOptions{}))
+		var opts excelize.Table
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Table{}), strict)
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		opts = goVal.Elem().Interface().(excelize.SheetPropsOptions)
-		if err = f.SetSheetProps(args[0].String(), &opts); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+		opts = goVal.Elem().Interface().(excelize.Table)
+		if err := sw.AddTable(&opts); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetSheetRow writes an array to row by given worksheet name, starting cell
-// reference and a pointer to array type 'slice'.
-func SetSheetRow(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// StreamFlush ending the streaming writing process.
+func StreamFlush(sw *excelize.StreamWriter) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeObject}},
-		}); err != nil {
-			ret["error"] = err.Error()
+		if err := prepareArgs(args, []argsRule{}); err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		length := args[2].Length()
-		var slice []interface{}
-		for i := 0; i < length; i++ {
-			arg := args[2].Index(i)
-			switch arg.Type() {
-			case js.TypeBoolean:
-				slice = append(slice, arg.Bool())
-			case js.TypeNumber:
-				slice = append(slice, arg.Float())
-			case js.TypeString:
-				slice = append(slice, arg.String())
-			default:
-				slice = append(slice, nil)
-			}
-		}
-		if err := f.SetSheetRow(args[0].String(), args[1].String(), &slice); err != nil {
-			ret["error"] = err.Error()
+		if err := sw.Flush(); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetSheetView sets sheet view options. The viewIndex may be negative and if
-// so is counted backward (-1 is the last view).
-func SetSheetView(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// StreamInsertPageBreak creates a page break to determine where the printed
+// page ends and where begins the next one by a given cell reference, the
+// content before the page break will be printed on one page and after the
+// page break on another.
+func StreamInsertPageBreak(sw *excelize.StreamWriter) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		err := prepareArgs(args, []argsRule{
+		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeNumber}},
-			{types: []js.Type{js.TypeObject}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var opts excelize.ViewOptions
-		goVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.ViewOptions{}))
-		if err != nil {
-			ret["error"] = err.Error()
+		}); err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		opts = goVal.Elem().Interface().(excelize.ViewOptions)
-		if err = f.SetSheetView(args[0].String(), args[1].Int(), &opts); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+		if err := sw.InsertPageBreak(args[0].String()); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetSheetVisible provides a function to set worksheet visible by given
-// worksheet name. A workbook must contain at least one visible worksheet. If
-// the given worksheet has been activated, this setting will be invalidated.
-func SetSheetVisible(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// StreamMergeCell provides a function to merge cells by a given range
+// reference for the stream writer. Don't create a merged cell that overlaps
+// with another existing merged cell.
+func StreamMergeCell(sw *excelize.StreamWriter) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeBoolean}},
+			{types: []js.Type{js.TypeString}},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.SetSheetVisible(args[0].String(), args[1].Bool()); err != nil {
-			ret["error"] = err.Error()
+		if err := sw.MergeCell(args[0].String(), args[1].String()); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// SetWorkbookProps provides a function to sets workbook properties.
-func SetWorkbookProps(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// StreamSetColWidth provides a function to set the width of a single column
+// or multiple columns for the stream writer. Note that you must call the
+// 'SetColWidth' function before the 'SetRow' function.
+func StreamSetColWidth(sw *excelize.StreamWriter) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeObject}},
-		})
-		if err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
-		}
-		var props excelize.WorkbookPropsOptions
-		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.WorkbookPropsOptions{}))
-		if err != nil {
-			ret["error"] = err.Error()
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+			{types: []js.Type{js.TypeNumber}},
+		}); err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		props = goVal.Elem().Interface().(excelize.WorkbookPropsOptions)
-		if err = f.SetWorkbookProps(&props); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+		if err := sw.SetColWidth(args[0].Int(), args[1].Int(), args[2].Float()); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// UngroupSheets provides a function to ungroup worksheets.
-func UngroupSheets(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// StreamSetPanes provides a function to create and remove freeze panes and
+// split panes by giving panes options for the stream writer. Note that you
+// must call the 'SetPanes' function before the 'SetRow' function.
+func StreamSetPanes(sw *excelize.StreamWriter, strict bool) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
+		if err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeObject}},
+		}); err != nil {
+			setError(ret, args, err)
+			return js.ValueOf(ret)
+		}
+		var panes excelize.Panes
+		goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Panes{}), strict)
+		if err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.UngroupSheets(); err != nil {
-			ret["error"] = err.Error()
+		panes = goVal.Elem().Interface().(excelize.Panes)
+		if err := sw.SetPanes(&panes); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// UnmergeCell provides a function to unmerge a given range reference.
-func UnmergeCell(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// StreamSetRow writes an array to stream rows by giving starting cell
+// reference and an array of values. Note that you must call the 'Flush'
+// function to end the streaming writing process. As a special case, if an
+// object with StyleID, Formula and Value fields is used as a value, then the
+// style and formula will be applied to that cell.
+func StreamSetRow(sw *excelize.StreamWriter, strict bool) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"error": nil}
 		if err := prepareArgs(args, []argsRule{
 			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
+			{types: []js.Type{js.TypeObject}},
+			{types: []js.Type{js.TypeObject}, opts: true},
 		}); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.UnmergeCell(args[0].String(), args[1].String(), args[2].String()); err != nil {
-			ret["error"] = err.Error()
+		length := args[1].Length()
+		slice := make([]interface{}, length)
+		for i := 0; i < length; i++ {
+			arg := args[1].Index(i)
+			if jsType(arg) != js.TypeObject || isJSDate(arg) {
+				slice[i] = jsValueToCellValue(arg)
+				continue
+			}
+			goVal, err := jsValueToGo(arg, reflect.TypeOf(excelize.Cell{}), strict)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			cell := goVal.Elem().Interface().(excelize.Cell)
+			cell.Value = jsValueToCellValue(arg.Get("Value"))
+			slice[i] = cell
+		}
+		var opts excelize.RowOpts
+		if len(args) == 3 {
+			goVal, err := jsValueToGo(args[2], reflect.TypeOf(excelize.RowOpts{}), strict)
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = goVal.Elem().Interface().(excelize.RowOpts)
+		}
+		if err := sw.SetRow(args[0].String(), slice, opts); err != nil {
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -4363,7 +6994,7 @@ func UnprotectSheet(f *excelize.File) func(this js.Value, args []js.Value) inter
 			{types: []js.Type{js.TypeString}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if len(args) == 2 {
@@ -4372,7 +7003,7 @@ func UnprotectSheet(f *excelize.File) func(this js.Value, args []js.Value) inter
 			err = f.UnprotectSheet(args[0].String())
 		}
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
@@ -4388,7 +7019,7 @@ func UnprotectWorkbook(f *excelize.File) func(this js.Value, args []js.Value) in
 			{types: []js.Type{js.TypeString}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		if len(args) == 1 {
@@ -4397,52 +7028,237 @@ func UnprotectWorkbook(f *excelize.File) func(this js.Value, args []js.Value) in
 			err = f.UnprotectWorkbook()
 		}
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 		}
 		return js.ValueOf(ret)
 	}
 }
 
-// UnsetConditionalFormat provides a function to unset the conditional format
-// by given worksheet name and range reference.
-func UnsetConditionalFormat(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
+// WriteTo provides a function to write the contents of the workbook to the
+// given JavaScript callback function, WritableStream or Node.js writable
+// stream, such as the fs.WriteStream. The zip archive will be forwarded to the
+// sink in chunks during the saving, instead of the whole buffer allocated in
+// memory, except for the encrypted workbook. The callback function will be
+// called synchronously, and a promise will be returned for the streams. The
+// progress of writing the parts of the zip archive will be reported to the
+// OnProgress callback function, and writing will be stopped if the Signal
+// aborted, the workbook is still usable after that. The other functions of
+// the workbook return the workbook busy error until the promise settled.
+func WriteTo(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{
-			{types: []js.Type{js.TypeString}},
-			{types: []js.Type{js.TypeString}},
-		}); err != nil {
-			ret["error"] = err.Error()
+		ret := map[string]interface{}{"bytes": 0, "error": nil}
+		err := prepareArgs(args, []argsRule{
+			{types: []js.Type{js.TypeFunction, js.TypeObject}},
+			{types: []js.Type{js.TypeObject}, opts: true},
+		})
+		if err != nil {
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		if err := f.UnsetConditionalFormat(args[0].String(), args[1].String()); err != nil {
-			ret["error"] = err.Error()
+		w, err := newJSChunkWriter(args[0])
+		if err != nil {
+			setError(ret, args, &argError{err: err, index: 0})
+			return js.ValueOf(ret)
 		}
-		return js.ValueOf(ret)
+		var (
+			opts excelize.Options
+			p    *progressTracker
+		)
+		if len(args) == 2 {
+			if p, err = progressOptions(args[1]); err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			goVal, err := jsValueToGo(args[1], reflect.TypeOf(excelize.Options{}), fileStates[f].strict, "OnProgress", "Signal")
+			if err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			opts = goVal.Elem().Interface().(excelize.Options)
+		}
+		write := func() interface{} {
+			err := writeToChunks(f, w, opts, p)
+			if err = w.close(err); err != nil {
+				setError(ret, args, err)
+			}
+			ret["bytes"] = w.n
+			return js.ValueOf(ret)
+		}
+		if w.kind == chunkSinkFunc {
+			return write()
+		}
+		st := fileStates[f]
+		st.writing = true
+		return newPromise(st.throwOnError, func() interface{} {
+			defer func() { st.writing = false }()
+			return write()
+		})
 	}
 }
 
-// UpdateLinkedValue fix linked values within a spreadsheet are not updating in
-// Office Excel application. This function will be remove value tag when met a
-// cell have a linked value.
-func UpdateLinkedValue(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
-	return func(this js.Value, args []js.Value) interface{} {
-		ret := map[string]interface{}{"error": nil}
-		if err := prepareArgs(args, []argsRule{}); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+// writeToChunks writes the workbook to the given chunk writer. The zip
+// archive will be written to the chunk writer directly by replacing the zip
+// writer of the workbook temporarily, the encrypted workbook will be written
+// after the whole zip archive generated.
+func writeToChunks(f *excelize.File, w *jsChunkWriter, opts excelize.Options, p *progressTracker) error {
+	bw := bufio.NewWriterSize(w, jsChunkSize)
+	zipWriter := f.ZipWriter
+	defer func() { f.ZipWriter = zipWriter }()
+	if opts.Password != "" {
+		f.ZipWriter = newProgressZipWriter(f, p, zipWriter)
+		if _, err := f.WriteTo(bw, opts); err != nil {
+			return err
 		}
-		if err := f.UpdateLinkedValue(); err != nil {
-			ret["error"] = err.Error()
-			return js.ValueOf(ret)
+		return bw.Flush()
+	}
+	f.ZipWriter = newProgressZipWriter(f, p, func(io.Writer) excelize.ZipWriter { return zip.NewWriter(bw) })
+	if _, err := f.WriteTo(io.Discard, opts); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// jsChunkSize defined the maximum size of the chunk forwarded to the
+// JavaScript sink.
+const jsChunkSize = 64 << 10
+
+// The kinds of the JavaScript sink of the jsChunkWriter.
+const (
+	chunkSinkFunc = iota
+	chunkSinkWebStream
+	chunkSinkNodeStream
+)
+
+// jsChunkWriter is an io.Writer which forwards the written bytes in the
+// Uint8Array chunks to the JavaScript callback function, WritableStream or
+// Node.js writable stream.
+type jsChunkWriter struct {
+	sink, writer, onError js.Value
+	kind                  int
+	n                     int64
+}
+
+// newJSChunkWriter creates the jsChunkWriter for the given JavaScript sink.
+// The writer of the WritableStream will be acquired here, so that a locked
+// stream will be reported before writing. An error listener will be added to
+// the Node.js writable stream, to avoid the unhandled error event crashes the
+// process, the error will be returned by the writer instead.
+func newJSChunkWriter(sink js.Value) (*jsChunkWriter, error) {
+	w := &jsChunkWriter{sink: sink}
+	switch {
+	case jsType(sink) == js.TypeFunction:
+		w.kind = chunkSinkFunc
+	case jsType(sink.Get("getWriter")) == js.TypeFunction:
+		writer, err := invokeJS(func() js.Value { return sink.Call("getWriter") })
+		if err != nil {
+			return nil, err
+		}
+		w.kind, w.writer = chunkSinkWebStream, writer
+	case jsType(sink.Get("write")) == js.TypeFunction && jsType(sink.Get("end")) == js.TypeFunction &&
+		jsType(sink.Get("once")) == js.TypeFunction:
+		w.kind, w.onError = chunkSinkNodeStream, noopFunc
+		sink.Call("once", "error", w.onError)
+	default:
+		return nil, errArgType
+	}
+	return w, nil
+}
+
+// Write forwards the given bytes to the JavaScript sink, and waits for the
+// WritableStream ready or the drain event of the Node.js writable stream.
+func (w *jsChunkWriter) Write(p []byte) (int, error) {
+	var n int
+	for len(p) > 0 {
+		size := min(len(p), jsChunkSize)
+		chunk := js.Global().Get("Uint8Array").New(size)
+		js.CopyBytesToJS(chunk, p[:size])
+		if err := w.writeChunk(chunk); err != nil {
+			return n, err
+		}
+		p, n, w.n = p[size:], n+size, w.n+int64(size)
+	}
+	return n, nil
+}
+
+// writeChunk forwards a Uint8Array chunk to the JavaScript sink.
+func (w *jsChunkWriter) writeChunk(chunk js.Value) error {
+	switch w.kind {
+	case chunkSinkWebStream:
+		if _, err := awaitJS(w.writer.Get("ready")); err != nil {
+			return err
+		}
+		_, err := awaitJS(w.writer.Call("write", chunk))
+		return err
+	case chunkSinkNodeStream:
+		ok, err := invokeJS(func() js.Value { return w.sink.Call("write", chunk) })
+		if err != nil {
+			return err
+		}
+		if err = w.nodeStreamError(); err != nil || ok.Truthy() {
+			return err
+		}
+		_, err = awaitJS(nodeEvent(w.sink, "drain"))
+		return err
+	default:
+		_, err := invokeJS(func() js.Value { return w.sink.Invoke(chunk) })
+		return err
+	}
+}
+
+// nodeStreamError returns the error of the destroyed Node.js writable stream.
+func (w *jsChunkWriter) nodeStreamError() error {
+	if reason := w.sink.Get("errored"); reason.Truthy() {
+		return jsReasonToError(reason)
+	}
+	if w.sink.Get("destroyed").Truthy() {
+		return errors.New("stream destroyed")
+	}
+	return nil
+}
+
+// close closes the JavaScript stream after all chunks have been written, or
+// aborts the stream if the given error is not nil, and returns the error of
+// writing or closing the stream.
+func (w *jsChunkWriter) close(err error) error {
+	switch w.kind {
+	case chunkSinkWebStream:
+		if err != nil {
+			_, _ = awaitJS(w.writer.Call("abort", err.Error()))
+			return err
+		}
+		_, err = awaitJS(w.writer.Call("close"))
+	case chunkSinkNodeStream:
+		if err != nil {
+			if w.nodeStreamError() == nil && jsType(w.sink.Get("destroy")) == js.TypeFunction {
+				w.sink.Call("destroy", js.Global().Get("Error").New(err.Error()))
+			}
+			return err
+		}
+		if err = w.nodeStreamError(); err != nil {
+			return err
+		}
+		finish := nodeEvent(w.sink, "finish")
+		if _, err = invokeJS(func() js.Value { return w.sink.Call("end") }); err != nil {
+			return err
+		}
+		if _, err = awaitJS(finish); err == nil {
+			w.sink.Call("removeListener", "error", w.onError)
 		}
-		return js.ValueOf(ret)
 	}
+	return err
+}
+
+// nodeEvent returns a promise which will be fulfilled on the given event of
+// the Node.js stream, or be rejected on the error event.
+func nodeEvent(emitter js.Value, event string) js.Value {
+	return nodeEventFunc.Invoke(emitter, event)
 }
 
 // WriteToBuffer provides a function to get the contents buffer from the saved
 // file, and it allocates space in memory. Be careful when the file size is
-// large.
+// large. The progress of writing the parts of the zip archive will be
+// reported to the OnProgress callback function, and writing will be stopped
+// if the Signal aborted, the workbook is still usable after that.
 func WriteToBuffer(f *excelize.File) func(this js.Value, args []js.Value) interface{} {
 	return func(this js.Value, args []js.Value) interface{} {
 		ret := map[string]interface{}{"buffer": js.ValueOf([]interface{}{}), "error": nil}
@@ -4450,21 +7266,31 @@ func WriteToBuffer(f *excelize.File) func(this js.Value, args []js.Value) interf
 			{types: []js.Type{js.TypeObject}, opts: true},
 		})
 		if err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
-		var opts excelize.Options
+		var (
+			opts excelize.Options
+			p    *progressTracker
+		)
 		if len(args) == 1 {
-			goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Options{}))
+			if p, err = progressOptions(args[0]); err != nil {
+				setError(ret, args, err)
+				return js.ValueOf(ret)
+			}
+			goVal, err := jsValueToGo(args[0], reflect.TypeOf(excelize.Options{}), fileStates[f].strict, "OnProgress", "Signal")
 			if err != nil {
-				ret["error"] = err.Error()
+				setError(ret, args, err)
 				return js.ValueOf(ret)
 			}
 			opts = goVal.Elem().Interface().(excelize.Options)
 		}
+		zipWriter := f.ZipWriter
+		defer func() { f.ZipWriter = zipWriter }()
+		f.ZipWriter = newProgressZipWriter(f, p, zipWriter)
 		buf := new(bytes.Buffer)
 		if err := f.Write(buf, opts); err != nil {
-			ret["error"] = err.Error()
+			setError(ret, args, err)
 			return js.ValueOf(ret)
 		}
 		src := buf.Bytes()
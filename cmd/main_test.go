@@ -4,12 +4,18 @@ import (
 	"archive/zip"
 	"bytes"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
 	"syscall/js"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/xuri/excelize/v2"
@@ -17,11 +23,46 @@ import (
 
 var MacintoshCyrillicCharset = []byte{0x8F, 0xF0, 0xE8, 0xE2, 0xE5, 0xF2, 0x20, 0xEC, 0xE8, 0xF0}
 
+func TestMain(m *testing.M) {
+	// Install the JavaScript helper functions defined in the src/index.js
+	src, err := os.ReadFile(filepath.Join("..", "src", "index.js"))
+	if err != nil {
+		panic(err)
+	}
+	start := bytes.Index(src, []byte("const helpers = {"))
+	end := bytes.Index(src[start:], []byte("\n};\n"))
+	if start == -1 || end == -1 {
+		panic("missing helpers in the src/index.js")
+	}
+	helpers := js.Global().Get("Function").New("return " + string(src[start+len("const helpers = "):start+end+2])).Invoke()
+	js.Global().Set("excelize", map[string]interface{}{"helpers": helpers})
+	regHelpers()
+	os.Exit(m.Run())
+}
+
 func TestRegInteropFunc(t *testing.T) {
 	js.Global().Set("excelize", map[string]interface{}{})
 	regFuncs()
 }
 
+func TestBindings(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	typ := reflect.TypeOf(&excelize.File{})
+	for i := 0; i < typ.NumMethod(); i++ {
+		name := typ.Method(i).Name
+		if _, ok := unboundFuncs[name]; ok {
+			continue
+		}
+		assert.Equal(t, js.TypeFunction, f.(js.Value).Get(name).Type(),
+			"no binding for the File.%s, run go generate or list it in the unboundFuncs", name)
+	}
+	for name := range unboundFuncs {
+		_, ok := typ.MethodByName(name)
+		assert.True(t, ok, "the unbound function %s is not a method of the File", name)
+	}
+}
+
 func TestInTypeSlice(t *testing.T) {
 	assert.Equal(t, -1, inTypeSlice(nil, js.TypeBoolean))
 	assert.Equal(t, 0, inTypeSlice([]js.Type{js.TypeBoolean}, js.TypeBoolean))
@@ -43,6 +84,65 @@ func TestPrepareArgs(t *testing.T) {
 		}), errArgType)
 }
 
+func TestErrorInfo(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	ret := f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(1))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.True(t, ret.Get("errorInfo").IsUndefined())
+
+	ret = f.(js.Value).Call("GetCellValue")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+	assert.Equal(t, "ERR_ARG_NUM", ret.Get("errorInfo").Get("code").String())
+	assert.Equal(t, errArgNum.Error(), ret.Get("errorInfo").Get("message").String())
+	assert.True(t, ret.Get("errorInfo").Get("argIndex").IsNull())
+	assert.True(t, ret.Get("errorInfo").Get("path").IsNull())
+
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf(1))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "ERR_ARG_TYPE", ret.Get("errorInfo").Get("code").String())
+	assert.Equal(t, 1, ret.Get("errorInfo").Get("argIndex").Int())
+
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("SheetN"), js.ValueOf("A1"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+	assert.Equal(t, "ERR_SHEET_NOT_EXIST", ret.Get("errorInfo").Get("code").String())
+	assert.True(t, ret.Get("errorInfo").Get("argIndex").IsNull())
+
+	ret = f.(js.Value).Call("NewSheet", js.ValueOf("Sheet:1"))
+	assert.Equal(t, "ERR_SHEET_NAME_INVALID", ret.Get("errorInfo").Get("code").String())
+
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A"), js.ValueOf(1))
+	assert.Equal(t, "ERR_UNKNOWN", ret.Get("errorInfo").Get("code").String())
+
+	// Test get error information with the option field path
+	ret = f.(js.Value).Call("AddChart", js.ValueOf("Sheet1"), js.ValueOf("E1"), js.ValueOf(map[string]interface{}{
+		"Type": int(excelize.Line),
+		"Series": []interface{}{
+			map[string]interface{}{"Name": "Sheet1!$A$1", "Values": "Sheet1!$B$1:$D$1"},
+			map[string]interface{}{"Name": "Sheet1!$A$2", "Values": "Sheet1!$B$2:$D$2", "Marker": map[string]interface{}{"Symbol": 1}},
+		},
+	}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "ERR_ARG_TYPE", ret.Get("errorInfo").Get("code").String())
+	assert.Equal(t, 2, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "Series[1].Marker.Symbol", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"RawCellValue": 1}))
+	assert.Equal(t, 1, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "RawCellValue", ret.Get("errorInfo").Get("path").String())
+
+	ret = NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"Password": 1})}).(js.Value)
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, 0, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "Password", ret.Get("errorInfo").Get("path").String())
+
+	err := withArgPath(withArgPath(errArgType, "Symbol", js.Undefined()), "Marker", js.Null())
+	assert.ErrorIs(t, err, errArgType)
+	assert.Equal(t, "Marker.Symbol", err.(*argError).path)
+	assert.Equal(t, "ERR_PARAMETER_INVALID", errorCode(fmt.Errorf("%w", excelize.ErrParameterInvalid)))
+}
+
 func TestCellNameToCoordinates(t *testing.T) {
 	ret := CellNameToCoordinates(js.Value{}, []js.Value{js.ValueOf("A1")})
 	assert.Equal(t, 1, ret.(js.Value).Get("col").Int())
@@ -158,6 +258,255 @@ func TestThemeColor(t *testing.T) {
 	assert.EqualError(t, errArgNum, ret.(js.Value).Get("error").String())
 }
 
+func TestThrowOnError(t *testing.T) {
+	js.Global().Set("excelize", map[string]interface{}{})
+	regFuncs()
+	excelizeJS := js.Global().Get("excelize")
+	assert.True(t, excelizeJS.Get("ExcelizeError").Equal(excelizeError))
+
+	// Test workbook in the exception mode by the option
+	f := NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"ThrowOnError": true})})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	assert.True(t, f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf("value")).IsUndefined())
+	assert.Equal(t, "value", f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1")).String())
+	assert.Equal(t, 1, f.(js.Value).Call("GetRows", js.ValueOf("Sheet1")).Length())
+	ret := f.(js.Value).Call("GetCalcProps")
+	assert.True(t, ret.Get("error").IsUndefined())
+
+	err := catchError(func() {
+		f.(js.Value).Call("GetCellValue", js.ValueOf("SheetN"), js.ValueOf("A1"))
+	})
+	assert.EqualError(t, err, "JavaScript error: sheet SheetN does not exist")
+	jsErr := err.(js.Error).Value
+	assert.True(t, jsErr.InstanceOf(excelizeError))
+	assert.True(t, jsErr.InstanceOf(js.Global().Get("Error")))
+	assert.Equal(t, "ExcelizeError", jsErr.Get("name").String())
+	assert.Equal(t, "ERR_SHEET_NOT_EXIST", jsErr.Get("code").String())
+	assert.True(t, jsErr.Get("argIndex").IsNull())
+
+	err = catchError(func() {
+		f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(true), js.ValueOf(true))
+	})
+	assert.EqualError(t, err, "JavaScript error: "+errArgNum.Error())
+	assert.Equal(t, "ERR_ARG_NUM", err.(js.Error).Value.Get("code").String())
+
+	// Test derived objects of the workbook in the exception mode
+	rows := f.(js.Value).Call("Rows", js.ValueOf("Sheet1"))
+	assert.True(t, rows.Get("error").IsUndefined())
+	assert.True(t, rows.Call("Next").Bool())
+	assert.Equal(t, "value", rows.Call("Columns").Index(0).String())
+	assert.True(t, rows.Call("Close").IsUndefined())
+	err = catchError(func() { f.(js.Value).Call("Rows", js.ValueOf("SheetN")) })
+	assert.EqualError(t, err, "JavaScript error: sheet SheetN does not exist")
+
+	// Test call functions of the closed workbook in the exception mode
+	assert.True(t, f.(js.Value).Call("Close").IsUndefined())
+	for _, fn := range []func(){
+		func() { f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1")) },
+		func() { rows.Call("Next") },
+	} {
+		err = catchError(fn)
+		assert.EqualError(t, err, "JavaScript error: file closed")
+		assert.Equal(t, "ERR_FILE_CLOSED", err.(js.Error).Value.Get("code").String())
+	}
+
+	// Test the global exception mode
+	ret = SetThrowOnError(js.Value{}, []js.Value{js.ValueOf(true)}).(js.Value)
+	assert.True(t, ret.Get("error").IsNull())
+	defer SetThrowOnError(js.Value{}, []js.Value{js.ValueOf(false)})
+	ret = excelizeJS.Call("CellNameToCoordinates", js.ValueOf("B3"))
+	assert.True(t, ret.Get("error").IsUndefined())
+	assert.Equal(t, 2, ret.Get("col").Int())
+	assert.Equal(t, 3, ret.Get("row").Int())
+	assert.Equal(t, "B", excelizeJS.Call("ColumnNumberToName", js.ValueOf(2)).String())
+	err = catchError(func() { excelizeJS.Call("CellNameToCoordinates", js.ValueOf("A")) })
+	assert.EqualError(t, err, "JavaScript error: cannot convert cell \"A\" to coordinates: invalid cell name \"A\"")
+	err = catchError(func() { excelizeJS.Call("SetThrowOnError") })
+	assert.EqualError(t, err, "JavaScript error: "+errArgNum.Error())
+
+	f = excelizeJS.Call("NewFile")
+	assert.True(t, f.(js.Value).Get("error").IsUndefined())
+	err = catchError(func() { f.(js.Value).Call("GetCellValue", js.ValueOf("SheetN"), js.ValueOf("A1")) })
+	assert.EqualError(t, err, "JavaScript error: sheet SheetN does not exist")
+
+	// Test disable exception mode for the workbook by the option
+	f = excelizeJS.Call("NewFile", js.ValueOf(map[string]interface{}{"ThrowOnError": false}))
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("SheetN"), js.ValueOf("A1"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	buf := f.(js.Value).Call("WriteToBuffer").Get("buffer")
+	f = excelizeJS.Call("OpenReader", buf)
+	assert.Equal(t, "Sheet1", f.(js.Value).Call("GetSheetName", js.ValueOf(0)).String())
+	f = excelizeJS.Call("OpenReader", buf, js.ValueOf(map[string]interface{}{"ThrowOnError": false}))
+	assert.Equal(t, "Sheet1", f.(js.Value).Call("GetSheetName", js.ValueOf(0)).Get("name").String())
+
+	// Test the exception mode with invalid arguments
+	ret = SetThrowOnError(js.Value{}, []js.Value{}).(js.Value)
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"ThrowOnError": "true"})}).(js.Value)
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = OpenReader(js.Value{}, []js.Value{buf, js.ValueOf(map[string]interface{}{"ThrowOnError": 1})}).(js.Value)
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	// Test create ExcelizeError by the constructor
+	jsErr = excelizeError.New("message", js.ValueOf(map[string]interface{}{"code": "ERR_UNKNOWN"}))
+	assert.True(t, jsErr.InstanceOf(js.Global().Get("Error")))
+	assert.Equal(t, "message", jsErr.Get("message").String())
+	assert.Equal(t, "ERR_UNKNOWN", jsErr.Get("code").String())
+}
+
+func TestStrictMode(t *testing.T) {
+	js.Global().Set("excelize", map[string]interface{}{})
+	regFuncs()
+	excelizeJS := js.Global().Get("excelize")
+
+	// Test unknown option fields are ignored in the non-strict mode
+	f := NewFile(js.Value{}, []js.Value{})
+	ret := f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"Bordr": []interface{}{}}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	// Test the strict mode for the workbook by the option
+	f = NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"Strict": true})})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	ret = f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"Bordr": []interface{}{}}))
+	assert.EqualError(t, errArgField, ret.Get("error").String())
+	assert.Equal(t, "ERR_ARG_FIELD", ret.Get("errorInfo").Get("code").String())
+	assert.Equal(t, 0, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "Bordr", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"Alignment": map[string]interface{}{"Horizontal": "Center"}}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"NumFmt": 1.5}))
+	assert.EqualError(t, errArgValue, ret.Get("error").String())
+	assert.Equal(t, "NumFmt", ret.Get("errorInfo").Get("path").String())
+
+	series := func() []interface{} {
+		return []interface{}{
+			map[string]interface{}{"Name": "Sheet1!$A$1", "Values": "Sheet1!$B$1:$D$1"},
+			map[string]interface{}{"Name": "Sheet1!$A$2", "Values": "Sheet1!$B$2:$D$2"},
+			map[string]interface{}{"Name": "Sheet1!$A$3", "Values": "Sheet1!$B$3:$D$3"},
+		}
+	}
+	chartSeries := series()
+	chartSeries[2].(map[string]interface{})["Marker"] = map[string]interface{}{"Symbol": "sqare"}
+	ret = f.(js.Value).Call("AddChart", js.ValueOf("Sheet1"), js.ValueOf("E1"), js.ValueOf(map[string]interface{}{
+		"Type": int(excelize.Line), "Series": chartSeries,
+	}))
+	assert.EqualError(t, errArgValue, ret.Get("error").String())
+	assert.Equal(t, "ERR_ARG_VALUE", ret.Get("errorInfo").Get("code").String())
+	assert.Equal(t, 2, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "Series[2].Marker.Symbol", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("AddChart", js.ValueOf("Sheet1"), js.ValueOf("E1"), js.ValueOf(map[string]interface{}{
+		"Typ": int(excelize.Line), "Series": series(),
+	}))
+	assert.EqualError(t, errArgField, ret.Get("error").String())
+	assert.Equal(t, "Typ", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("AddChart", js.ValueOf("Sheet1"), js.ValueOf("E1"), js.ValueOf(map[string]interface{}{
+		"Type": 100, "Series": series(),
+	}))
+	assert.EqualError(t, errArgValue, ret.Get("error").String())
+	assert.Equal(t, "Type", ret.Get("errorInfo").Get("path").String())
+
+	chartSeries = series()
+	chartSeries[0].(map[string]interface{})["Name"] = 1
+	ret = f.(js.Value).Call("AddChart", js.ValueOf("Sheet1"), js.ValueOf("E1"), js.ValueOf(map[string]interface{}{
+		"Type": int(excelize.Line), "Series": chartSeries,
+	}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "Series[0].Name", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("AddChart", js.ValueOf("Sheet1"), js.ValueOf("E1"), js.ValueOf(map[string]interface{}{
+		"Type": int(excelize.Line), "Series": series(),
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	// Test the custom option fields of the wrappers in the strict mode
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": true, "BigInt": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typo": true}))
+	assert.EqualError(t, errArgField, ret.Get("error").String())
+	assert.Equal(t, 1, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "Typo", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf([]interface{}{
+		map[string]interface{}{"Cell": "A1", "Value": 1},
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("A1:B2"), js.ValueOf(map[string]interface{}{"Bycolumn": true}))
+	assert.EqualError(t, errArgField, ret.Get("error").String())
+	assert.Equal(t, 2, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "Bycolumn", ret.Get("errorInfo").Get("path").String())
+
+	// Test the stream writer uses the option validation mode of its workbook
+	f.(js.Value).Call("NewSheet", js.ValueOf("Sheet2"))
+	sw := f.(js.Value).Call("NewStreamWriter", js.ValueOf("Sheet2"))
+	assert.True(t, sw.Get("error").IsNull())
+	nonStrict := NewFile(js.Value{}, []js.Value{}).(js.Value)
+	swNonStrict := nonStrict.Call("NewStreamWriter", js.ValueOf("Sheet1"))
+	ret = sw.Call("SetPanes", js.ValueOf(map[string]interface{}{"Freeze": true, "Spilt": true}))
+	assert.EqualError(t, errArgField, ret.Get("error").String())
+	assert.Equal(t, "Spilt", ret.Get("errorInfo").Get("path").String())
+	ret = swNonStrict.Call("SetPanes", js.ValueOf(map[string]interface{}{"Freeze": true, "Spilt": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	for _, w := range []js.Value{sw, swNonStrict} {
+		ret = w.Call("SetRow", js.ValueOf("A1"), js.ValueOf([]interface{}{1}), js.ValueOf(map[string]interface{}{"Heigth": 20}))
+		assert.Equal(t, w.Equal(swNonStrict), ret.Get("error").IsNull())
+	}
+
+	// Test the strict mode with invalid options
+	ret = NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"Strict": 1})}).(js.Value)
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "Strict", ret.Get("errorInfo").Get("path").String())
+	ret = NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"Strict": true, "Foo": 1})}).(js.Value)
+	assert.EqualError(t, errArgField, ret.Get("error").String())
+	assert.Equal(t, "Foo", ret.Get("errorInfo").Get("path").String())
+
+	// Test the global strict mode
+	ret = SetStrictMode(js.Value{}, []js.Value{}).(js.Value)
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+	ret = SetStrictMode(js.Value{}, []js.Value{js.ValueOf(true)}).(js.Value)
+	assert.True(t, ret.Get("error").IsNull())
+	defer SetStrictMode(js.Value{}, []js.Value{js.ValueOf(false)})
+	ret = excelizeJS.Call("NewFile", js.ValueOf(map[string]interface{}{"Foo": 1}))
+	assert.EqualError(t, errArgField, ret.Get("error").String())
+	f = excelizeJS.Call("NewFile")
+	ret = f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"Bordr": []interface{}{}}))
+	assert.EqualError(t, errArgField, ret.Get("error").String())
+	f = excelizeJS.Call("NewFile", js.ValueOf(map[string]interface{}{"Strict": false}))
+	ret = f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"Bordr": []interface{}{}}))
+	assert.True(t, ret.Get("error").IsNull())
+}
+
+func TestOptionEnums(t *testing.T) {
+	// Test the enumeration values defined for the string option fields
+	assert.NotEmpty(t, optionEnums)
+	for typ, fields := range optionEnums {
+		assert.Equal(t, reflect.Struct, typ.Kind(), typ.String())
+		for name, enums := range fields {
+			field, ok := typ.FieldByName(name)
+			if !assert.True(t, ok, "missing field %s.%s", typ, name) {
+				continue
+			}
+			kind := field.Type.Kind()
+			if kind == reflect.Ptr {
+				kind = field.Type.Elem().Kind()
+			}
+			assert.Equal(t, reflect.String, kind, "%s.%s", typ, name)
+			assert.NotEmpty(t, enums, "%s.%s", typ, name)
+		}
+	}
+	// Test the maximum values defined for the numeric enumeration data types
+	assert.NotEmpty(t, optionEnumTypes)
+	for typ := range optionEnumTypes {
+		assert.Contains(t, []reflect.Kind{reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64}, typ.Kind(), typ.String())
+	}
+}
+
 func TestNewFile(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -223,6 +572,142 @@ func TestOpenReader(t *testing.T) {
 	assert.EqualError(t, errArgNum, ret.(js.Value).Get("error").String())
 }
 
+func TestOpenReaderBinaryData(t *testing.T) {
+	buf, err := excelize.NewFile().WriteToBuffer()
+	assert.NoError(t, err)
+	uint8Array := js.Global().Get("Uint8Array").New(buf.Len())
+	js.CopyBytesToJS(uint8Array, buf.Bytes())
+
+	// Test open the workbook from the ArrayBuffer, DataView, Node.js Buffer
+	// and the typed array view of the part of the buffer
+	padded := js.Global().Get("Uint8Array").New(buf.Len() + 8)
+	padded.Call("set", uint8Array, 4)
+	for _, data := range []js.Value{
+		uint8Array.Get("buffer"),
+		js.Global().Get("DataView").New(uint8Array.Get("buffer")),
+		js.Global().Get("Buffer").Call("from", uint8Array),
+		padded.Call("subarray", 4, buf.Len()+4),
+	} {
+		ret := OpenReader(js.Value{}, []js.Value{data})
+		assert.True(t, ret.(js.Value).Get("error").IsNull())
+		assert.Equal(t, "Sheet1", ret.(js.Value).Call("GetSheetName", js.ValueOf(0)).Get("name").String())
+	}
+
+	// Test open the workbook from the Blob and ReadableStream incrementally
+	chunks := []interface{}{
+		uint8Array.Call("slice", 0, 100), uint8Array.Call("slice", 100, 1000), uint8Array.Call("slice", 1000),
+	}
+	for _, data := range []js.Value{
+		js.Global().Get("Blob").New(js.ValueOf(chunks)),
+		newReadableStream(chunks...),
+	} {
+		promise := OpenReader(js.Value{}, []js.Value{data}).(js.Value)
+		assert.True(t, promise.InstanceOf(js.Global().Get("Promise")))
+		f, err := awaitPromise(promise)
+		assert.NoError(t, err)
+		assert.True(t, f.Get("error").IsNull())
+		assert.Equal(t, "Sheet1", f.Call("GetSheetName", js.ValueOf(0)).Get("name").String())
+	}
+
+	f, err := awaitPromise(OpenReader(js.Value{}, []js.Value{newReadableStream(uint8Array.Call("slice", 0, 100))}).(js.Value))
+	assert.NoError(t, err)
+	assert.EqualError(t, zip.ErrFormat, f.Get("error").String())
+
+	f, err = awaitPromise(OpenReader(js.Value{}, []js.Value{newReadableStream(js.ValueOf("chunk"))}).(js.Value))
+	assert.NoError(t, err)
+	assert.EqualError(t, errArgType, f.Get("error").String())
+
+	// Test open the workbook from the ReadableStream in the exception mode
+	_, err = awaitPromise(OpenReader(js.Value{}, []js.Value{
+		newReadableStream(uint8Array.Call("slice", 0, 100)),
+		js.ValueOf(map[string]interface{}{"ThrowOnError": true}),
+	}).(js.Value))
+	assert.EqualError(t, err, zip.ErrFormat.Error())
+	errStream := js.Global().Get("Function").New("return new ReadableStream({ pull(c) { c.error(new Error('read failed')); } })").Invoke()
+	_, err = awaitPromise(OpenReader(js.Value{}, []js.Value{errStream, js.ValueOf(map[string]interface{}{"ThrowOnError": true})}).(js.Value))
+	assert.EqualError(t, err, "read failed")
+	ret := SetThrowOnError(js.Value{}, []js.Value{js.ValueOf(true)}).(js.Value)
+	assert.True(t, ret.Get("error").IsNull())
+	defer SetThrowOnError(js.Value{}, []js.Value{js.ValueOf(false)})
+	_, err = awaitPromise(OpenReader(js.Value{}, []js.Value{newReadableStream(uint8Array.Call("slice", 0, 100))}).(js.Value))
+	assert.EqualError(t, err, zip.ErrFormat.Error())
+	f, err = awaitPromise(OpenReader(js.Value{}, []js.Value{
+		newReadableStream(uint8Array.Call("slice", 0, 100)),
+		js.ValueOf(map[string]interface{}{"ThrowOnError": false}),
+	}).(js.Value))
+	assert.NoError(t, err)
+	assert.EqualError(t, zip.ErrFormat, f.Get("error").String())
+	f, err = awaitPromise(OpenReader(js.Value{}, []js.Value{newReadableStream(uint8Array)}).(js.Value))
+	assert.NoError(t, err)
+	assert.True(t, f.Get("error").IsUndefined())
+	assert.Equal(t, "Sheet1", f.Call("GetSheetName", js.ValueOf(0)).String())
+}
+
+func TestOpenReaderProgress(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	assert.True(t, f.(js.Value).Call("NewSheet", js.ValueOf("Sheet2")).Get("error").IsNull())
+	assert.True(t, f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet2"), js.ValueOf("A1"), js.ValueOf("Hello")).Get("error").IsNull())
+	buffer := f.(js.Value).Call("WriteToBuffer").Get("buffer")
+
+	// Test open the workbook with the progress callback function
+	progress := newProgressRecorder("")
+	ret := OpenReader(js.Value{}, []js.Value{buffer, js.ValueOf(map[string]interface{}{
+		"OnProgress": progress.Get("onProgress"), "Signal": progress.Get("controller").Get("signal"), "Strict": true,
+	})})
+	assert.True(t, ret.(js.Value).Get("error").IsNull())
+	assert.Equal(t, "Hello", ret.(js.Value).Call("GetCellValue", js.ValueOf("Sheet2"), js.ValueOf("A1")).Get("value").String())
+	events := progress.Get("events").Call("join", " ").String()
+	assert.Regexp(t, `^unzip:0:(\d+) unzip:(\d+):(\d+)$`, events)
+
+	// Test cancel opening the workbook by the signal
+	for _, abortAt := range []string{"unzip:0", events[strings.LastIndex(events, " ")+1:]} {
+		progress = newProgressRecorder(abortAt)
+		ret = OpenReader(js.Value{}, []js.Value{buffer, js.ValueOf(map[string]interface{}{
+			"OnProgress": progress.Get("onProgress"), "Signal": progress.Get("controller").Get("signal"),
+		})})
+		assert.EqualError(t, errCancelled, ret.(js.Value).Get("error").String())
+		assert.Equal(t, "ERR_CANCELLED", ret.(js.Value).Get("errorInfo").Get("code").String())
+	}
+	ret = OpenReader(js.Value{}, []js.Value{buffer, js.ValueOf(map[string]interface{}{
+		"Signal": js.Global().Get("AbortSignal").Call("abort"),
+	})})
+	assert.EqualError(t, errCancelled, ret.(js.Value).Get("error").String())
+
+	// Test open the workbook with the progress callback function which throws
+	ret = OpenReader(js.Value{}, []js.Value{buffer, js.ValueOf(map[string]interface{}{
+		"OnProgress": js.Global().Get("Function").New("throw new Error('progress failed')"),
+	})})
+	assert.Equal(t, "progress failed", ret.(js.Value).Get("error").String())
+
+	// Test open the workbook from the Blob with the progress of reading
+	progress = newProgressRecorder("")
+	blob := js.Global().Get("Blob").New(js.ValueOf([]interface{}{buffer}))
+	ret, err := awaitPromise(OpenReader(js.Value{}, []js.Value{blob, js.ValueOf(map[string]interface{}{
+		"OnProgress": progress.Get("onProgress"),
+	})}).(js.Value))
+	assert.NoError(t, err)
+	assert.True(t, ret.(js.Value).Get("error").IsNull())
+	assert.Contains(t, progress.Get("events").Call("join", " ").String(),
+		fmt.Sprintf("read:%d:%d unzip:0", buffer.Get("length").Int(), buffer.Get("length").Int()))
+
+	// Test cancel reading the ReadableStream by the signal
+	progress = newProgressRecorder("read")
+	ret, err = awaitPromise(OpenReader(js.Value{}, []js.Value{newReadableStream(buffer), js.ValueOf(map[string]interface{}{
+		"OnProgress": progress.Get("onProgress"), "Signal": progress.Get("controller").Get("signal"),
+	})}).(js.Value))
+	assert.NoError(t, err)
+	assert.EqualError(t, errCancelled, ret.(js.Value).Get("error").String())
+
+	// Test open the workbook with invalid progress options
+	for _, path := range []string{"OnProgress", "Signal"} {
+		ret = OpenReader(js.Value{}, []js.Value{buffer, js.ValueOf(map[string]interface{}{path: true})})
+		assert.EqualError(t, errArgType, ret.(js.Value).Get("error").String())
+		assert.Equal(t, path, ret.(js.Value).Get("errorInfo").Get("path").String())
+		assert.Equal(t, 1, ret.(js.Value).Get("errorInfo").Get("argIndex").Int())
+	}
+}
+
 func TestAddChart(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -553,6 +1038,24 @@ func TestAddHeaderFooterImage(t *testing.T) {
 
 	ret = f.(js.Value).Call("AddHeaderFooterImage", js.ValueOf("SheetN"), opts)
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	// Test add header footer image from the Blob
+	opts = js.ValueOf(map[string]interface{}{
+		"File":      js.Global().Get("Blob").New(js.ValueOf([]interface{}{uint8Array})),
+		"Extension": ".png",
+		"Width":     "50pt",
+		"Height":    "32pt",
+	})
+	ret, err = awaitPromise(f.(js.Value).Call("AddHeaderFooterImage", js.ValueOf("Sheet1"), opts))
+	assert.NoError(t, err)
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, js.TypeObject, opts.Get("File").Type())
+
+	opts.Set("Width", 1)
+	ret = f.(js.Value).Call("AddHeaderFooterImage", js.ValueOf("Sheet1"), opts)
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, 1, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "Width", ret.Get("errorInfo").Get("path").String())
 }
 
 func TestAddIgnoredErrors(t *testing.T) {
@@ -635,6 +1138,25 @@ func TestAddPictureFromBytes(t *testing.T) {
 
 	ret = f.(js.Value).Call("GetPictureCells", js.ValueOf("SheetN"))
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	// Test add picture from the Node.js Buffer and ReadableStream
+	pic.Set("File", js.Global().Get("Buffer").Call("from", uint8Array))
+	ret = f.(js.Value).Call("AddPictureFromBytes", js.ValueOf("Sheet1"), js.ValueOf("B1"), pic)
+	assert.True(t, ret.Get("error").IsNull())
+
+	pic.Set("File", newReadableStream(uint8Array.Call("slice", 0, 64), uint8Array.Call("slice", 64)))
+	ret, err = awaitPromise(f.(js.Value).Call("AddPictureFromBytes", js.ValueOf("Sheet1"), js.ValueOf("C1"), pic))
+	assert.NoError(t, err)
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetPictures", js.ValueOf("Sheet1"), js.ValueOf("C1"))
+	assert.Equal(t, uint8Array.Length(), ret.Get("pictures").Index(0).Get("File").Length())
+
+	pic.Set("File", newReadableStream(js.ValueOf(1)))
+	ret, err = awaitPromise(f.(js.Value).Call("AddPictureFromBytes", js.ValueOf("Sheet1"), js.ValueOf("D1"), pic))
+	assert.NoError(t, err)
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, 2, ret.Get("errorInfo").Get("argIndex").Int())
+	assert.Equal(t, "File", ret.Get("errorInfo").Get("path").String())
 }
 
 func TestPivotTable(t *testing.T) {
@@ -714,6 +1236,20 @@ func TestPivotTable(t *testing.T) {
 
 	ret = f.(js.Value).Call("GetPivotTables", js.ValueOf("SheetN"))
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	name := f.(js.Value).Call("GetPivotTables", js.ValueOf("Sheet1")).Get("opts").Index(0).Get("Name")
+	ret = f.(js.Value).Call("DeletePivotTable", js.ValueOf("Sheet1"), name)
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 0, f.(js.Value).Call("GetPivotTables", js.ValueOf("Sheet1")).Get("opts").Length())
+
+	ret = f.(js.Value).Call("DeletePivotTable")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("DeletePivotTable", js.ValueOf("Sheet1"), js.ValueOf(nil))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("DeletePivotTable", js.ValueOf("Sheet1"), name)
+	assert.Equal(t, fmt.Sprintf("table %s does not exist", name.String()), ret.Get("error").String())
 }
 
 func TestAddShape(t *testing.T) {
@@ -913,6 +1449,28 @@ func TestAddVBAProject(t *testing.T) {
 
 	ret = f.(js.Value).Call("AddVBAProject", js.ValueOf(true))
 	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("AddVBAProject", js.ValueOf(map[string]interface{}{}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	// Test add VBA project from the ArrayBuffer and ReadableStream
+	uint8Array = js.Global().Get("Uint8Array").New(js.ValueOf(len(oleIdentifier)))
+	js.CopyBytesToJS(uint8Array, oleIdentifier)
+	ret = f.(js.Value).Call("AddVBAProject", uint8Array.Get("buffer"))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret, err := awaitPromise(f.(js.Value).Call("AddVBAProject", newReadableStream(uint8Array.Call("slice", 0, 4), uint8Array.Call("slice", 4))))
+	assert.NoError(t, err)
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret, err = awaitPromise(f.(js.Value).Call("AddVBAProject", newReadableStream(uint8Array.Call("slice", 0, 4))))
+	assert.NoError(t, err)
+	assert.Equal(t, excelize.ErrAddVBAProject.Error(), ret.Get("error").String())
+
+	errStream := js.Global().Get("Function").New("return new ReadableStream({ pull(c) { c.error(new Error('read failed')); } })").Invoke()
+	ret, err = awaitPromise(f.(js.Value).Call("AddVBAProject", errStream))
+	assert.NoError(t, err)
+	assert.Equal(t, "read failed", ret.Get("error").String())
 }
 
 func TestAutoFilter(t *testing.T) {
@@ -932,6 +1490,26 @@ func TestAutoFilter(t *testing.T) {
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
 }
 
+func TestAutoFitColWidth(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	ret := f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("B1"), js.ValueOf("a long text content in the cell"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("AutoFitColWidth", js.ValueOf("Sheet1"), js.ValueOf("A:B"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Greater(t, f.(js.Value).Call("GetColWidth", js.ValueOf("Sheet1"), js.ValueOf("B")).Get("width").Float(), 9.140625)
+
+	ret = f.(js.Value).Call("AutoFitColWidth")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("AutoFitColWidth", js.ValueOf("Sheet1"), js.ValueOf(1))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("AutoFitColWidth", js.ValueOf("SheetN"), js.ValueOf("A"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
 func TestCalcCellValue(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -952,6 +1530,24 @@ func TestCalcCellValue(t *testing.T) {
 
 	ret = f.(js.Value).Call("CalcCellValue", js.ValueOf("SheetN"), js.ValueOf("A1"))
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	// Test calculate the cell value with the progress callback function
+	progress := newProgressRecorder("")
+	ret = f.(js.Value).Call("CalcCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(map[string]interface{}{
+		"OnProgress": progress.Get("onProgress"), "Signal": progress.Get("controller").Get("signal"),
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "calc:0:1 calc:1:1", progress.Get("events").Call("join", " ").String())
+
+	// Test cancel calculating the cell value by the signal
+	ret = f.(js.Value).Call("CalcCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(map[string]interface{}{
+		"Signal": js.Global().Get("AbortSignal").Call("abort"),
+	}))
+	assert.EqualError(t, errCancelled, ret.Get("error").String())
+	ret = f.(js.Value).Call("CalcCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(map[string]interface{}{
+		"OnProgress": js.ValueOf(1),
+	}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
 }
 
 func TestCalcProps(t *testing.T) {
@@ -993,6 +1589,269 @@ func TestCalcProps(t *testing.T) {
 	assert.EqualError(t, errArgType, ret.Get("error").String())
 }
 
+func TestCharsetTranscoder(t *testing.T) {
+	wb := excelize.NewFile()
+	wb.Sheet.Delete("xl/worksheets/sheet1.xml")
+	wb.Pkg.Store("xl/worksheets/sheet1.xml", append(append([]byte(`<?xml version="1.0" encoding="x-vendor-cyrillic"?>`+
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1">`+
+		`<c r="A1" t="inlineStr"><is><t>`), MacintoshCyrillicCharset...), []byte(`</t></is></c></row></sheetData></worksheet>`)...))
+	buf, err := wb.WriteToBuffer()
+	assert.NoError(t, err)
+	uint8Array := js.Global().Get("Uint8Array").New(buf.Len())
+	js.CopyBytesToJS(uint8Array, buf.Bytes())
+
+	// Test get cell value with unsupported charset
+	f := OpenReader(js.Value{}, []js.Value{uint8Array})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	ret := f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+	assert.Equal(t, "xml: opening charset \"x-vendor-cyrillic\": unsupported charset: \"x-vendor-cyrillic\"", ret.Get("error").String())
+
+	// Test get cell value with the transcoder function and encoding name
+	var labels []string
+	transcoder := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		labels = append(labels, args[0].String())
+		return js.Global().Get("TextDecoder").New("x-mac-cyrillic").Call("decode", args[1])
+	})
+	defer transcoder.Release()
+	for _, opt := range []js.Value{transcoder.Value, js.ValueOf("x-mac-cyrillic"), js.ValueOf("macintosh-cyrillic")} {
+		f = OpenReader(js.Value{}, []js.Value{uint8Array})
+		assert.True(t, f.(js.Value).Get("error").IsNull())
+		ret = f.(js.Value).Call("CharsetTranscoder", opt)
+		if opt.Type() == js.TypeString && opt.String() == "macintosh-cyrillic" {
+			assert.EqualError(t, errArgValue, ret.Get("error").String())
+			continue
+		}
+		assert.True(t, ret.Get("error").IsNull())
+		ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+		assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+		assert.Equal(t, "Привет мир", ret.Get("value").String())
+	}
+	assert.NotEmpty(t, labels)
+	for _, label := range labels {
+		assert.Equal(t, "x-vendor-cyrillic", label)
+	}
+
+	// Test open the workbook with the transcoder in the options
+	f = OpenReader(js.Value{}, []js.Value{uint8Array, js.ValueOf(map[string]interface{}{"CharsetReader": "x-mac-cyrillic", "Strict": true})})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+	assert.Equal(t, "Привет мир", ret.Get("value").String())
+	f = OpenReader(js.Value{}, []js.Value{uint8Array, js.ValueOf(map[string]interface{}{
+		"CharsetReader": transcoder, "OnProgress": js.Global().Get("Function").New(""),
+	})})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+	assert.Equal(t, "Привет мир", ret.Get("value").String())
+	f = OpenReader(js.Value{}, []js.Value{uint8Array, js.ValueOf(map[string]interface{}{"CharsetReader": "unknown"})})
+	assert.EqualError(t, errArgValue, f.(js.Value).Get("error").String())
+	assert.Equal(t, "CharsetReader", f.(js.Value).Get("errorInfo").Get("path").String())
+	f = OpenReader(js.Value{}, []js.Value{uint8Array, js.ValueOf(map[string]interface{}{"CharsetReader": true})})
+	assert.EqualError(t, errArgType, f.(js.Value).Get("error").String())
+
+	// Test open the workbook with the non UTF-8 workbook part, which parsed on
+	// opening, the declared label will be used if it's supported
+	workbookWithLabel := func(label string, name []byte) js.Value {
+		assert.NoError(t, wb.SetSheetName(wb.GetSheetName(0), "Лист1"))
+		buf, err := wb.WriteToBuffer()
+		assert.NoError(t, err)
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.NoError(t, err)
+		var out bytes.Buffer
+		zw := zip.NewWriter(&out)
+		for _, file := range zr.File {
+			rc, err := file.Open()
+			assert.NoError(t, err)
+			content, err := io.ReadAll(rc)
+			assert.NoError(t, err)
+			assert.NoError(t, rc.Close())
+			if file.Name == "xl/workbook.xml" {
+				content = bytes.Replace(content, []byte(`encoding="UTF-8"`), []byte(`encoding="`+label+`"`), 1)
+				content = bytes.Replace(content, []byte("Лист1"), name, 1)
+			}
+			w, err := zw.Create(file.Name)
+			assert.NoError(t, err)
+			_, err = w.Write(content)
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, zw.Close())
+		data := js.Global().Get("Uint8Array").New(out.Len())
+		js.CopyBytesToJS(data, out.Bytes())
+		return data
+	}
+	data := workbookWithLabel("x-vendor-cyrillic", []byte{0x8B, 0xE8, 0xF1, 0xF2, 0x31})
+	f = OpenReader(js.Value{}, []js.Value{data})
+	assert.Equal(t, "xml: opening charset \"x-vendor-cyrillic\": unsupported charset: \"x-vendor-cyrillic\"", f.(js.Value).Get("error").String())
+	for _, opt := range []js.Value{transcoder.Value, js.ValueOf("x-mac-cyrillic")} {
+		f = OpenReader(js.Value{}, []js.Value{data, js.ValueOf(map[string]interface{}{"CharsetReader": opt})})
+		assert.True(t, f.(js.Value).Get("error").IsNull())
+		ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Лист1"), js.ValueOf("A1"))
+		assert.True(t, ret.Get("error").IsNull())
+		assert.Equal(t, "Привет мир", ret.Get("value").String())
+	}
+	f = OpenReader(js.Value{}, []js.Value{workbookWithLabel("windows-1251", []byte{0xCB, 0xE8, 0xF1, 0xF2, 0x31}),
+		js.ValueOf(map[string]interface{}{"CharsetReader": "x-mac-cyrillic"})})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Лист1"), js.ValueOf("A1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "Привет мир", ret.Get("value").String())
+
+	// Test the transcoder function returns undefined, invalid value or throws
+	for transcoder, expected := range map[string]string{
+		"return undefined": "xml: opening charset \"x-vendor-cyrillic\": unsupported charset: \"x-vendor-cyrillic\"",
+		"return 1":         "xml: opening charset \"x-vendor-cyrillic\": invalid argument data type",
+		"throw new Error('unsupported encoding')": "xml: opening charset \"x-vendor-cyrillic\": unsupported encoding",
+	} {
+		f = OpenReader(js.Value{}, []js.Value{uint8Array})
+		assert.True(t, f.(js.Value).Get("error").IsNull())
+		ret = f.(js.Value).Call("CharsetTranscoder", js.Global().Get("Function").New("charset", "input", transcoder))
+		assert.True(t, ret.Get("error").IsNull())
+		ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+		assert.Equal(t, expected, ret.Get("error").String())
+	}
+
+	ret = f.(js.Value).Call("CharsetTranscoder")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+	ret = f.(js.Value).Call("CharsetTranscoder", js.ValueOf(true))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+}
+
+func TestClose(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	ret := f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf("value"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("MergeCell", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf("B2"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetMergeCells", js.ValueOf("Sheet1"))
+	assert.True(t, ret.Get("error").IsNull())
+	mergeCell := ret.Get("mergeCells").Index(0)
+	rows := f.(js.Value).Call("Rows", js.ValueOf("Sheet1"))
+	assert.True(t, rows.Get("error").IsNull())
+	iterator := asyncIterator(rows)
+	sw := f.(js.Value).Call("NewStreamWriter", js.ValueOf("Sheet1"))
+	assert.True(t, sw.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("Close", js.ValueOf(true))
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("Close")
+	assert.True(t, ret.Get("error").IsNull())
+	assert.True(t, ret.Get("errorInfo").IsUndefined())
+
+	// Test call functions of the closed workbook and its derived objects
+	for _, ret := range []js.Value{
+		f.(js.Value).Call("Close"),
+		f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1")),
+		f.(js.Value).Call("WriteToBuffer"),
+		mergeCell.Call("GetCellValue"),
+		rows.Call("Next"),
+		rows.Call("Close"),
+		sw.Call("Flush"),
+	} {
+		assert.Equal(t, "file closed", ret.Get("error").String())
+		assert.Equal(t, "ERR_FILE_CLOSED", ret.Get("errorInfo").Get("code").String())
+	}
+	_, err := awaitPromise(iterator.Call("next"))
+	assert.EqualError(t, err, "file closed")
+	_, err = awaitPromise(asyncIterator(rows).Call("next"))
+	assert.EqualError(t, err, "file closed")
+}
+
+func TestCols(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	ret := f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf([]interface{}{"A", "B"}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf("A2"), js.ValueOf([]interface{}{0.5, 2}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"NumFmt": 9}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetCellStyle", js.ValueOf("Sheet1"), js.ValueOf("A2"), js.ValueOf("A2"), ret.Get("style"))
+	assert.True(t, ret.Get("error").IsNull())
+
+	cols := f.(js.Value).Call("Cols", js.ValueOf("Sheet1"))
+	assert.True(t, cols.Get("error").IsNull())
+	var result [][]string
+	for cols.Call("Next").Bool() {
+		ret = cols.Call("Rows")
+		assert.True(t, ret.Get("error").IsNull())
+		var col []string
+		for r := 0; r < ret.Get("result").Length(); r++ {
+			col = append(col, ret.Get("result").Index(r).String())
+		}
+		result = append(result, col)
+	}
+	assert.Equal(t, [][]string{{"A", "50%"}, {"B", "2"}}, result)
+	assert.True(t, cols.Call("Error").Get("error").IsNull())
+
+	// Test get rows of each column with the options
+	cols = f.(js.Value).Call("Cols", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, cols.Get("error").IsNull())
+	assert.True(t, cols.Call("Next").Bool())
+	ret = cols.Call("Rows")
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "0.5", ret.Get("result").Index(1).String())
+
+	cols = f.(js.Value).Call("Cols", js.ValueOf("Sheet1"))
+	assert.True(t, cols.Get("error").IsNull())
+	assert.True(t, cols.Call("Next").Bool())
+	ret = cols.Call("Rows", js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "0.5", ret.Get("result").Index(1).String())
+
+	// Test iterate columns with the async iterator
+	cols = f.(js.Value).Call("Cols", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, cols.Get("error").IsNull())
+	iterator := asyncIterator(cols)
+	result = nil
+	for {
+		item, err := awaitPromise(iterator.Call("next"))
+		assert.NoError(t, err)
+		if item.Get("done").Bool() {
+			break
+		}
+		var col []string
+		for r := 0; r < item.Get("value").Length(); r++ {
+			col = append(col, item.Get("value").Index(r).String())
+		}
+		result = append(result, col)
+	}
+	assert.Equal(t, [][]string{{"A", "0.5"}, {"B", "2"}}, result)
+	item, err := awaitPromise(iterator.Call("return"))
+	assert.NoError(t, err)
+	assert.True(t, item.Get("done").Bool())
+
+	// Test call functions of the finished columns iterator
+	assert.False(t, cols.Call("Next").Bool())
+	assert.True(t, cols.Call("Error").Get("error").IsNull())
+	ret = cols.Call("Rows")
+	assert.EqualError(t, errObjectClosed, ret.Get("error").String())
+	assert.Equal(t, "ERR_OBJECT_CLOSED", ret.Get("errorInfo").Get("code").String())
+	item, err = awaitPromise(asyncIterator(cols).Call("next"))
+	assert.NoError(t, err)
+	assert.True(t, item.Get("done").Bool())
+
+	// Test columns iterator with invalid arguments
+	cols = f.(js.Value).Call("Cols", js.ValueOf("Sheet1"))
+	assert.True(t, cols.Get("error").IsNull())
+	ret = cols.Call("Rows", js.ValueOf(map[string]interface{}{"RawCellValue": "true"}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = cols.Call("Rows", js.ValueOf(true))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("Cols")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("Cols", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"RawCellValue": "true"}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("Cols", js.ValueOf("SheetN"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
 func TestCopySheet(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -1144,11 +2003,102 @@ func TestDuplicateRowTo(t *testing.T) {
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
 }
 
-func TestGetActiveSheetIndex(t *testing.T) {
+func TestExportCSV(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
-
-	ret := f.(js.Value).Call("GetActiveSheetIndex")
+	for cell, values := range map[string][]interface{}{
+		"A1": {"Name", "Value", "Note", "Hidden"},
+		"A2": {"a,b", 0.5, `say "hi"`, "x"},
+		"A3": {" pad", 2, "line\nbreak", "y"},
+		"A4": {"skip", 3},
+	} {
+		ret := f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf(cell), js.ValueOf(values))
+		assert.True(t, ret.Get("error").IsNull())
+	}
+	ret := f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"NumFmt": 10}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetCellStyle", js.ValueOf("Sheet1"), js.ValueOf("B2"), js.ValueOf("B2"), ret.Get("style"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetColVisible", js.ValueOf("Sheet1"), js.ValueOf("D"), js.ValueOf(false))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetRowVisible", js.ValueOf("Sheet1"), js.ValueOf(4), js.ValueOf(false))
+	assert.True(t, ret.Get("error").IsNull())
+	exportCSV := func(args ...interface{}) []byte {
+		ret := f.(js.Value).Call("ExportCSV", args...)
+		assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+		buf := make([]byte, ret.Get("buffer").Length())
+		js.CopyBytesToGo(buf, ret.Get("buffer"))
+		return buf
+	}
+
+	// Test export CSV with default and custom options
+	for _, c := range []struct {
+		opts     map[string]interface{}
+		expected string
+	}{
+		{nil, "Name,Value,Note,Hidden\r\n\"a,b\",50.00%,\"say \"\"hi\"\"\",x\r\n\" pad\",2,\"line\nbreak\",y\r\nskip,3,,\r\n"},
+		{
+			map[string]interface{}{"Quote": "all", "LineEnding": "\n", "RawCellValue": true, "HiddenRows": "skip", "HiddenCols": "skip"},
+			"\"Name\",\"Value\",\"Note\"\n\"a,b\",\"0.5\",\"say \"\"hi\"\"\"\n\" pad\",\"2\",\"line\nbreak\"\n",
+		},
+		{
+			map[string]interface{}{"Quote": "nonNumeric", "Delimiter": ";", "RawCellValue": true, "Range": "A2:D4"},
+			"\"a,b\";0.5;\"say \"\"hi\"\"\";\"x\"\r\n\" pad\";2;\"line\nbreak\";\"y\"\r\n\"skip\";3;\"\";\"\"\r\n",
+		},
+		{map[string]interface{}{"Quote": "None", "Delimiter": "\t", "Range": "C3:B2"}, "50.00%\tsay \"hi\"\r\n2\tline\nbreak\r\n"},
+		{map[string]interface{}{"Range": "C4:E5"}, ",,\r\n,,\r\n"},
+		{map[string]interface{}{"Range": "A1:A6", "HiddenRows": "skip"}, "Name\r\n\"a,b\"\r\n\" pad\"\r\n\r\n\r\n"},
+		{map[string]interface{}{"Range": "A1", "BOM": true}, "\ufeffName\r\n"},
+		{map[string]interface{}{"Range": "A1", "BOM": true, "Encoding": "utf-16le"}, "\xff\xfeN\x00a\x00m\x00e\x00\r\x00\n\x00"},
+	} {
+		args := []interface{}{"Sheet1"}
+		if c.opts != nil {
+			args = append(args, c.opts)
+		}
+		assert.Equal(t, c.expected, string(exportCSV(args...)))
+	}
+
+	// Test export CSV with the hidden empty row after the cell values
+	ret = f.(js.Value).Call("SetRowVisible", js.ValueOf("Sheet1"), js.ValueOf(5), js.ValueOf(false))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "Name\r\n\"a,b\"\r\n\" pad\"\r\n\r\n", string(exportCSV("Sheet1", map[string]interface{}{"Range": "A1:A6", "HiddenRows": "skip"})))
+	assert.Equal(t, "Name\r\n\"a,b\"\r\n\" pad\"\r\nskip\r\n\r\n\r\n", string(exportCSV("Sheet1", map[string]interface{}{"Range": "A1:A6"})))
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf("Привет"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, []byte{0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2, 0x0d, 0x0a}, exportCSV("Sheet1", map[string]interface{}{"Range": "A1", "Encoding": "windows-1251"}))
+
+	// Test export CSV with invalid options
+	for path, opts := range map[string]map[string]interface{}{
+		"Delimiter":  {"Delimiter": ",,"},
+		"Quote":      {"Quote": "some"},
+		"LineEnding": {"LineEnding": "\r"},
+		"HiddenRows": {"HiddenRows": "hide"},
+		"Encoding":   {"Encoding": "unknown"},
+		"BOM":        {"BOM": true, "Encoding": "windows-1251"},
+		"Range":      {"Range": "A"},
+	} {
+		ret = f.(js.Value).Call("ExportCSV", js.ValueOf("Sheet1"), js.ValueOf(opts))
+		assert.EqualError(t, errArgValue, ret.Get("error").String())
+		assert.Equal(t, 1, ret.Get("errorInfo").Get("argIndex").Int())
+		assert.Equal(t, path, ret.Get("errorInfo").Get("path").String())
+		assert.Zero(t, ret.Get("buffer").Length())
+	}
+	ret = f.(js.Value).Call("ExportCSV", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Delimiter": 1}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "Delimiter", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("ExportCSV")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("ExportCSV", js.ValueOf("SheetN"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
+func TestGetActiveSheetIndex(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	ret := f.(js.Value).Call("GetActiveSheetIndex")
 	assert.True(t, ret.Get("error").IsNull())
 	assert.Equal(t, 0, ret.Get("index").Int())
 
@@ -1193,6 +2143,53 @@ func TestGetAppProps(t *testing.T) {
 	assert.True(t, ret.Get("props").IsUndefined())
 }
 
+func TestGetCellDate(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	date := js.Global().Get("Date").New(2024, 0, 1, 12, 30, 15)
+	ret := f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), date)
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("GetCellDate", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, date.Call("getTime").Float(), ret.Get("value").Call("getTime").Float())
+
+	// Test get date value with the 1904 date system
+	ret = f.(js.Value).Call("SetWorkbookProps", js.ValueOf(map[string]interface{}{"Date1904": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A2"), date)
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A2"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.True(t, strings.HasPrefix(ret.Get("value").String(), "43830.52"))
+
+	ret = f.(js.Value).Call("GetCellDate", js.ValueOf("Sheet1"), js.ValueOf("A2"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, date.Call("getTime").Float(), ret.Get("value").Call("getTime").Float())
+
+	// Test get date value from the non-numeric and empty cells
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A3"), js.ValueOf("foo"))
+	assert.True(t, ret.Get("error").IsNull())
+	for _, cell := range []string{"A3", "A4"} {
+		ret = f.(js.Value).Call("GetCellDate", js.ValueOf("Sheet1"), js.ValueOf(cell))
+		assert.True(t, ret.Get("error").IsNull())
+		assert.True(t, ret.Get("value").IsNull())
+	}
+
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A5"), js.ValueOf(-1))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetCellDate", js.ValueOf("Sheet1"), js.ValueOf("A5"))
+	assert.Equal(t, "invalid date value -1.000000, negative values are not supported", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetCellDate")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetCellDate", js.ValueOf("SheetN"), js.ValueOf("A1"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
 func TestGetCellFormula(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -1429,6 +2426,202 @@ func TestGetMergeCells(t *testing.T) {
 	assert.Equal(t, 0, ret.Get("mergeCells").Length())
 }
 
+func TestGetRangeValues(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	for cell, value := range map[string]interface{}{"B2": 1.5, "C2": "text", "B3": 3, "C4": -2, "D4": "NaN"} {
+		ret := f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf(cell), js.ValueOf(value))
+		assert.True(t, ret.Get("error").IsNull())
+	}
+	ret := f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("C4:B2"), js.ValueOf(map[string]interface{}{"As": "float64"}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 3, ret.Get("rows").Int())
+	assert.Equal(t, 2, ret.Get("cols").Int())
+	values, valid := ret.Get("values"), ret.Get("valid")
+	assert.True(t, values.InstanceOf(js.Global().Get("Float64Array")))
+	assert.True(t, valid.InstanceOf(js.Global().Get("Uint8Array")))
+	assert.Equal(t, 6, values.Length())
+	assert.Equal(t, 1, valid.Length())
+	assert.Equal(t, 0b100101, valid.Index(0).Int())
+	for i, expected := range []float64{1.5, math.NaN(), 3, math.NaN(), math.NaN(), -2} {
+		if math.IsNaN(expected) {
+			assert.True(t, math.IsNaN(values.Index(i).Float()))
+			continue
+		}
+		assert.Equal(t, expected, values.Index(i).Float())
+	}
+
+	// Test get range values by column
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("B2:D4"), js.ValueOf(map[string]interface{}{"ByColumn": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 3, ret.Get("values").Length())
+	assert.Equal(t, 3, ret.Get("values").Index(0).Length())
+	assert.Equal(t, 3.0, ret.Get("values").Index(0).Index(1).Float())
+	assert.Equal(t, 0b011, ret.Get("valid").Index(0).Index(0).Int())
+	assert.Equal(t, 0b100, ret.Get("valid").Index(1).Index(0).Int())
+	assert.Equal(t, 0, ret.Get("valid").Index(2).Index(0).Int())
+
+	// Test get range values of the single cell
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("B3"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 3.0, ret.Get("values").Index(0).Float())
+	assert.Equal(t, 1, ret.Get("valid").Index(0).Int())
+
+	// Test get range values of the whole worksheet with boolean cells
+	for cell, value := range map[string]interface{}{"E3": true, "E4": false, "E5": 1} {
+		ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf(cell), js.ValueOf(value))
+		assert.True(t, ret.Get("error").IsNull())
+	}
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("A1:XFD1048576"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 5, ret.Get("rows").Int())
+	assert.Equal(t, 5, ret.Get("cols").Int())
+	assert.Equal(t, 25, ret.Get("values").Length())
+	assert.Equal(t, 4, ret.Get("valid").Length())
+	assert.True(t, math.IsNaN(ret.Get("values").Index(14).Float()))
+	assert.True(t, math.IsNaN(ret.Get("values").Index(19).Float()))
+	assert.Equal(t, 1.0, ret.Get("values").Index(24).Float())
+	assert.Equal(t, 0, ret.Get("valid").Index(1).Int()&(1<<6))
+	assert.Equal(t, 0, ret.Get("valid").Index(2).Int()&(1<<3))
+	assert.Equal(t, 1, ret.Get("valid").Index(3).Int())
+
+	// Test get range values out of the cells of the worksheet
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("F10:XFD1048576"), js.ValueOf(map[string]interface{}{"ByColumn": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 0, ret.Get("rows").Int())
+	assert.Equal(t, 0, ret.Get("cols").Int())
+	assert.Equal(t, 0, ret.Get("values").Length())
+
+	// Test get range values with invalid arguments
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"))
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("B2:B3"), js.ValueOf(map[string]interface{}{"As": 1}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "As", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("B2:B3"), js.ValueOf(map[string]interface{}{"As": "int32"}))
+	assert.EqualError(t, excelize.ErrParameterInvalid, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("B2:B3"), js.ValueOf(map[string]interface{}{"ByColumn": 1}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "ByColumn", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("A1:B2:C3"))
+	assert.EqualError(t, excelize.ErrParameterInvalid, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("Sheet1"), js.ValueOf("A:B2"))
+	assert.Equal(t, "cannot convert cell \"A\" to coordinates: invalid cell name \"A\"", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetRangeValues", js.ValueOf("SheetN"), js.ValueOf("A1"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
+func TestGetRecords(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	date := js.Global().Get("Date").New(2024, 0, 15)
+	for cell, values := range map[string][]interface{}{
+		"A1": {"Name", "Amount", nil, " Name ", nil, "Date"},
+		"A2": {"Bob", 12.5, true, "x", 1, date},
+		"A4": {"Amy", 3},
+	} {
+		ret := f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf(cell), js.ValueOf(values))
+		assert.True(t, ret.Get("error").IsNull())
+	}
+	ret := f.(js.Value).Call("MergeCell", js.ValueOf("Sheet1"), js.ValueOf("B1"), js.ValueOf("C1"))
+	assert.True(t, ret.Get("error").IsNull())
+
+	// Test get records with default and custom options
+	empty := map[string]interface{}{"Name": "", "Amount": "", "Amount_2": "", "Name_2": "", "E": "", "Date": ""}
+	for _, c := range []struct {
+		opts     map[string]interface{}
+		expected []interface{}
+	}{
+		{nil, []interface{}{
+			map[string]interface{}{"Name": "Bob", "Amount": "12.5", "Amount_2": "TRUE", "Name_2": "x", "E": "1", "Date": "01-15-24"},
+			empty,
+			map[string]interface{}{"Name": "Amy", "Amount": "3", "Amount_2": "", "Name_2": "", "E": "", "Date": ""},
+		}},
+		{map[string]interface{}{"Typed": true, "SkipEmpty": true}, []interface{}{
+			map[string]interface{}{"Name": "Bob", "Amount": 12.5, "Amount_2": true, "Name_2": "x", "E": 1, "Date": date},
+			map[string]interface{}{"Name": "Amy", "Amount": 3, "Amount_2": nil, "Name_2": nil, "E": nil, "Date": nil},
+		}},
+		{map[string]interface{}{"Range": "C4:B1", "SkipEmpty": true, "RawCellValue": true}, []interface{}{
+			map[string]interface{}{"Amount": "12.5", "Amount_2": "1"},
+			map[string]interface{}{"Amount": "3", "Amount_2": ""},
+		}},
+		{map[string]interface{}{"Range": "A2:B3", "HeaderRow": 2}, []interface{}{map[string]interface{}{"Bob": "", "12.5": ""}}},
+		{map[string]interface{}{"HeaderRow": 4}, []interface{}{}},
+		{map[string]interface{}{"HeaderRow": 10}, []interface{}{}},
+	} {
+		args := []interface{}{"Sheet1"}
+		if c.opts != nil {
+			args = append(args, c.opts)
+		}
+		ret = f.(js.Value).Call("GetRecords", args...)
+		assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+		records := ret.Get("records")
+		assert.Equal(t, len(c.expected), records.Length())
+		for i := 0; i < len(c.expected) && i < records.Length(); i++ {
+			assertRecord(t, c.expected[i].(map[string]interface{}), records.Index(i))
+		}
+	}
+
+	// Test get records with the "__proto__" headers
+	ret = f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf("H1"), js.ValueOf([]interface{}{"__proto__", "__proto__"}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf("H2"), js.ValueOf([]interface{}{"a", "b"}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetRecords", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Range": "H1:I2"}))
+	assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+	assert.Equal(t, 1, ret.Get("records").Length())
+	record := ret.Get("records").Index(0)
+	assertRecord(t, map[string]interface{}{"__proto__": "a", "__proto___2": "b"}, record)
+	assert.True(t, js.Global().Get("Object").Call("getPrototypeOf", record).Equal(js.Global().Get("Object").Get("prototype")))
+
+	// Test get records with invalid options
+	for path, opts := range map[string]map[string]interface{}{
+		"HeaderRow": {"HeaderRow": 3, "Range": "A1:B2"},
+		"Range":     {"Range": "A"},
+	} {
+		ret = f.(js.Value).Call("GetRecords", js.ValueOf("Sheet1"), js.ValueOf(opts))
+		assert.EqualError(t, errArgValue, ret.Get("error").String())
+		assert.Equal(t, 1, ret.Get("errorInfo").Get("argIndex").Int())
+		assert.Equal(t, path, ret.Get("errorInfo").Get("path").String())
+		assert.Zero(t, ret.Get("records").Length())
+	}
+	ret = f.(js.Value).Call("GetRecords", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"HeaderRow": -1}))
+	assert.EqualError(t, errArgValue, ret.Get("error").String())
+	ret = f.(js.Value).Call("GetRecords", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": 1}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "Typed", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("GetRecords")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetRecords", js.ValueOf("SheetN"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
+// assertRecord asserts the fields of the JavaScript record object with the
+// expected values, the Date objects will be compared by the time value.
+func assertRecord(t *testing.T, expected map[string]interface{}, record js.Value) {
+	assert.Equal(t, len(expected), js.Global().Get("Object").Call("keys", record).Length(),
+		js.Global().Get("JSON").Call("stringify", record).String())
+	for name, value := range expected {
+		actual := record.Get(name)
+		if v, ok := value.(js.Value); ok && v.InstanceOf(js.Global().Get("Date")) {
+			assert.True(t, actual.InstanceOf(js.Global().Get("Date")), name)
+			assert.Equal(t, v.Call("getTime").Float(), actual.Call("getTime").Float(), name)
+			continue
+		}
+		assert.True(t, js.ValueOf(value).Equal(actual), "%s: expected %v (%s), actual %v (%s)",
+			name, value, js.ValueOf(value).Type(), actual, actual.Type())
+	}
+}
+
 func TestGetRowHeight(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -1534,6 +2727,85 @@ func TestGetRows(t *testing.T) {
 	assert.Equal(t, 0, ret.Get("result").Length())
 }
 
+func TestGetTypedCellValues(t *testing.T) {
+	wb := excelize.NewFile()
+	for _, style := range []*excelize.Style{
+		{NumFmt: 4}, {NumFmt: 14}, {CustomNumFmt: func(s string) *string { return &s }("[h]:mm")},
+	} {
+		_, err := wb.NewStyle(style)
+		assert.NoError(t, err)
+	}
+	wb.Sheet.Delete("xl/worksheets/sheet1.xml")
+	wb.Pkg.Store("xl/worksheets/sheet1.xml", []byte(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1">`+
+		`<c r="A1" t="inlineStr"><is><t>text</t></is></c><c r="B1" s="1"><v>1234.5</v></c><c r="C1" t="b"><v>1</v></c>`+
+		`<c r="D1" s="2"><v>45292.5</v></c><c r="E1" t="e"><v>#DIV/0!</v></c><c r="F1" t="d"><v>2024-01-01T12:00:00Z</v></c>`+
+		`<c r="G1" t="str"><f>"a"&amp;"b"</f><v>ab</v></c><c r="I1" s="3"><v>1.5</v></c></row></sheetData></worksheet>`))
+	buf, err := wb.WriteToBuffer()
+	assert.NoError(t, err)
+	uint8Array := js.Global().Get("Uint8Array").New(js.ValueOf(buf.Len()))
+	js.CopyBytesToJS(uint8Array, buf.Bytes())
+	f := OpenReader(js.Value{}, []js.Value{uint8Array})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	date := js.Global().Get("Date").New(2024, 0, 1, 12).Call("getTime").Float()
+	assertTypedValues := func(values js.Value) {
+		assert.Equal(t, 9, values.Length())
+		assert.Equal(t, "text", values.Index(0).String())
+		assert.Equal(t, 1234.5, values.Index(1).Float())
+		assert.True(t, values.Index(2).Bool())
+		assert.Equal(t, date, values.Index(3).Call("getTime").Float())
+		assert.Equal(t, "#DIV/0!", values.Index(4).Get("Error").String())
+		assert.Equal(t, date, values.Index(5).Call("getTime").Float())
+		assert.Equal(t, "ab", values.Index(6).String())
+		assert.True(t, values.Index(7).IsNull())
+		assert.True(t, values.Index(8).InstanceOf(js.Global().Get("Date")))
+	}
+	ret := f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assertTypedValues(ret.Get("result").Index(0))
+
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "1,234.50", ret.Get("result").Index(0).Index(1).String())
+
+	ret = f.(js.Value).Call("GetCols", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	values := make([]interface{}, ret.Get("result").Length())
+	for c := range values {
+		if ret.Get("result").Index(c).Length() == 0 {
+			values[c] = nil
+			continue
+		}
+		values[c] = ret.Get("result").Index(c).Index(0)
+	}
+	assertTypedValues(js.ValueOf(values))
+
+	values = make([]interface{}, 9)
+	for c := range values {
+		cell, err := excelize.CoordinatesToCellName(c+1, 1)
+		assert.NoError(t, err)
+		ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf(cell), js.ValueOf(map[string]interface{}{"Typed": true}))
+		assert.True(t, ret.Get("error").IsNull())
+		values[c] = ret.Get("value")
+	}
+	assertTypedValues(js.ValueOf(values))
+
+	for _, name := range []string{"GetRows", "GetCols"} {
+		ret = f.(js.Value).Call(name, js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": 1}))
+		assert.EqualError(t, errArgType, ret.Get("error").String())
+		assert.Equal(t, 0, ret.Get("result").Length())
+	}
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(map[string]interface{}{"Typed": 1}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	for numFmt, expected := range map[string]bool{
+		"yyyy-mm-dd": true, "[h]:mm:ss": true, "h:mm AM/PM": true, "[Red]0.00": false, "0.00\\m": false,
+		`"days "0`: false, `0"`: false, "[Red": false, "#,##0.00": false, "General": false,
+	} {
+		assert.Equal(t, expected, isDateCustomNumFmt(numFmt), numFmt)
+	}
+}
+
 func TestGetSheetIndex(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -1635,6 +2907,119 @@ func TestGroupSheets(t *testing.T) {
 	assert.EqualError(t, errArgNum, ret.Get("error").String())
 }
 
+func TestImportCSV(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	data := "Name;Score;Active;Joined;ID;Ratio\r\n\"Doe; \"\"J\"\"\";95.5;TRUE;2024-01-15;007;12.5%\r\n" +
+		"Amy;-1e3;false;2024-01-15T10:30:00;12345678901234567;50%\r\n"
+	getCell := func(cell string) (string, string, int) {
+		ret := f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf(cell))
+		assert.True(t, ret.Get("error").IsNull())
+		value := ret.Get("value").String()
+		ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf(cell), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+		assert.True(t, ret.Get("error").IsNull())
+		raw := ret.Get("value").String()
+		ret = f.(js.Value).Call("GetCellType", js.ValueOf("Sheet1"), js.ValueOf(cell))
+		assert.True(t, ret.Get("error").IsNull())
+		return value, raw, ret.Get("cellType").Int()
+	}
+
+	// Test import CSV with detected delimiter and inferred types
+	ret := f.(js.Value).Call("ImportCSV", js.ValueOf("Sheet1"), js.ValueOf("B2"), js.ValueOf(data), js.ValueOf(map[string]interface{}{"InferTypes": true}))
+	assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+	for cell, expected := range map[string][]interface{}{
+		"B2": {"Name", "Name", 7},
+		"B3": {`Doe; "J"`, `Doe; "J"`, 7},
+		"C3": {"95.5", "95.5", 0},
+		"D3": {"TRUE", "1", 1},
+		"E3": {"01-15-24", "45306", 0},
+		"F3": {"007", "007", 7},
+		"G3": {"12.50%", "0.125", 0},
+		"C4": {"-1000", "-1000", 0},
+		"D4": {"FALSE", "0", 1},
+		"E4": {"1/15/24 10:30", "45306.4375", 0},
+		"F4": {"12345678901234567", "12345678901234567", 7},
+		"G4": {"50%", "0.5", 0},
+		"H4": {"", "", 0},
+	} {
+		value, raw, cellType := getCell(cell)
+		assert.Equal(t, expected, []interface{}{value, raw, cellType}, cell)
+	}
+
+	// Test import CSV without inferred types
+	ret = f.(js.Value).Call("ImportCSV", js.ValueOf("Sheet1"), js.ValueOf("B2"), js.ValueOf(data))
+	assert.True(t, ret.Get("error").IsNull())
+	value, raw, cellType := getCell("C3")
+	assert.Equal(t, []interface{}{"95.5", "95.5", 7}, []interface{}{value, raw, cellType})
+
+	// Test import CSV with tab and custom delimiter, encoding and byte order mark
+	for _, c := range []struct {
+		data     interface{}
+		opts     map[string]interface{}
+		expected []string
+	}{
+		{"a\tb,c\n1\t2\n", nil, []string{"a", "b,c"}},
+		{"a|b,c|d\n", map[string]interface{}{"Delimiter": "|"}, []string{"a", "b,c", "d"}},
+		{[]byte("\xef\xbb\xbfa,b"), nil, []string{"a", "b"}},
+		{[]byte("\xff\xfea\x00,\x00b\x00"), nil, []string{"a", "b"}},
+		{[]byte{0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2, ';', '1'}, map[string]interface{}{"Encoding": "windows-1251"}, []string{"Привет", "1"}},
+	} {
+		f = NewFile(js.Value{}, []js.Value{})
+		var data js.Value
+		switch v := c.data.(type) {
+		case string:
+			data = js.ValueOf(v)
+		case []byte:
+			data = js.Global().Get("Uint8Array").New(len(v))
+			js.CopyBytesToJS(data, v)
+		}
+		args := []interface{}{"Sheet1", "A1", data}
+		if c.opts != nil {
+			args = append(args, c.opts)
+		}
+		ret = f.(js.Value).Call("ImportCSV", args...)
+		assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+		ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"))
+		assert.True(t, ret.Get("error").IsNull())
+		assert.Equal(t, strings.Join(c.expected, ","), ret.Get("result").Index(0).Call("join", ",").String())
+	}
+
+	// Test import CSV from the ReadableStream
+	f = NewFile(js.Value{}, []js.Value{})
+	encoder := js.Global().Get("TextEncoder").New()
+	ret, err := awaitPromise(f.(js.Value).Call("ImportCSV", js.ValueOf("Sheet1"), js.ValueOf("A1"),
+		newReadableStream(encoder.Call("encode", "a,\"b"), encoder.Call("encode", "\nc\",1"))))
+	assert.NoError(t, err)
+	assert.True(t, ret.Get("error").IsNull())
+	value, _, _ = getCell("B1")
+	assert.Equal(t, "b\nc", value)
+
+	// Test import CSV with invalid CSV data and options
+	ret = f.(js.Value).Call("ImportCSV", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf("a,b\"c\n"))
+	assert.Equal(t, "parse error on line 1, column 4: bare \" in non-quoted-field", ret.Get("error").String())
+	for path, opts := range map[string]map[string]interface{}{
+		"Delimiter": {"Delimiter": "\""},
+		"Encoding":  {"Encoding": "unknown"},
+	} {
+		ret = f.(js.Value).Call("ImportCSV", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf("a"), js.ValueOf(opts))
+		assert.EqualError(t, errArgValue, ret.Get("error").String())
+		assert.Equal(t, 3, ret.Get("errorInfo").Get("argIndex").Int())
+		assert.Equal(t, path, ret.Get("errorInfo").Get("path").String())
+	}
+	ret = f.(js.Value).Call("ImportCSV", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(map[string]interface{}{}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	ret = f.(js.Value).Call("ImportCSV", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(1))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	ret = f.(js.Value).Call("ImportCSV", js.ValueOf("Sheet1"), js.ValueOf("A"), js.ValueOf("a"))
+	assert.Equal(t, "cannot convert cell \"A\" to coordinates: invalid cell name \"A\"", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("ImportCSV")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("ImportCSV", js.ValueOf("SheetN"), js.ValueOf("A1"), js.ValueOf("a"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
 func TestInsertCols(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -1774,6 +3159,146 @@ func TestNewSheet(t *testing.T) {
 	assert.Equal(t, 0, ret.Get("index").Int())
 }
 
+func TestStreamWriter(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	ret := f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{
+		"Font": map[string]interface{}{"Color": "777777"},
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	styleID := ret.Get("style").Int()
+
+	sw := f.(js.Value).Call("NewStreamWriter", js.ValueOf("Sheet1"))
+	assert.True(t, sw.Get("error").IsNull())
+
+	ret = sw.Call("SetColWidth", js.ValueOf(1), js.ValueOf(3), js.ValueOf(20))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = sw.Call("SetPanes", js.ValueOf(map[string]interface{}{
+		"Freeze":      true,
+		"YSplit":      1,
+		"TopLeftCell": "A2",
+		"ActivePane":  "bottomLeft",
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = sw.Call("SetRow", js.ValueOf("A1"), js.ValueOf([]interface{}{
+		map[string]interface{}{"StyleID": styleID, "Value": "Name"},
+		"Value", "Enabled", nil,
+	}), js.ValueOf(map[string]interface{}{"Height": 45}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	for row := 2; row <= 5; row++ {
+		ret = sw.Call("SetRow", js.ValueOf(fmt.Sprintf("A%d", row)), js.ValueOf([]interface{}{
+			fmt.Sprintf("Item %d", row), row, row%2 == 0, js.Global().Get("Date").New(2024, 0, row),
+		}))
+		assert.True(t, ret.Get("error").IsNull())
+	}
+	ret = sw.Call("SetRow", js.ValueOf("A6"), js.ValueOf([]interface{}{
+		"Total", map[string]interface{}{"Formula": "SUM(B2:B5)"},
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = sw.Call("AddTable", js.ValueOf(map[string]interface{}{"Range": "A1:C5"}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = sw.Call("MergeCell", js.ValueOf("E1"), js.ValueOf("F2"))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = sw.Call("InsertPageBreak", js.ValueOf("A4"))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = sw.Call("Flush")
+	assert.True(t, ret.Get("error").IsNull())
+
+	// Test call functions of the flushed stream writer
+	for _, name := range []string{"Flush", "SetRow"} {
+		ret = sw.Call(name)
+		assert.EqualError(t, errObjectClosed, ret.Get("error").String())
+		assert.Equal(t, "ERR_OBJECT_CLOSED", ret.Get("errorInfo").Get("code").String())
+	}
+
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("B3"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "3", ret.Get("value").String())
+
+	ret = f.(js.Value).Call("GetCellStyle", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, styleID, ret.Get("style").Int())
+
+	ret = f.(js.Value).Call("GetCellDate", js.ValueOf("Sheet1"), js.ValueOf("D2"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, js.Global().Get("Date").New(2024, 0, 2).Call("getTime").Float(), ret.Get("value").Call("getTime").Float())
+
+	ret = f.(js.Value).Call("GetCellFormula", js.ValueOf("Sheet1"), js.ValueOf("B6"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "SUM(B2:B5)", ret.Get("formula").String())
+
+	ret = f.(js.Value).Call("GetTables", js.ValueOf("Sheet1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "A1:C5", ret.Get("tables").Index(0).Get("Range").String())
+
+	// Test stream writer functions with invalid arguments
+	sw = f.(js.Value).Call("NewStreamWriter", js.ValueOf("Sheet1"))
+	assert.True(t, sw.Get("error").IsNull())
+
+	for _, name := range []string{
+		"AddTable", "InsertPageBreak", "MergeCell", "SetColWidth", "SetPanes", "SetRow",
+	} {
+		ret = sw.Call(name)
+		assert.EqualError(t, errArgNum, ret.Get("error").String())
+	}
+	ret = sw.Call("Flush", js.ValueOf(true))
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = sw.Call("AddTable", js.ValueOf(map[string]interface{}{"Range": true}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = sw.Call("SetPanes", js.ValueOf(map[string]interface{}{"Freeze": "true"}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = sw.Call("SetRow", js.ValueOf("A1"), js.ValueOf([]interface{}{
+		map[string]interface{}{"StyleID": "1"},
+	}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = sw.Call("SetRow", js.ValueOf("A1"), js.ValueOf([]interface{}{1}), js.ValueOf(map[string]interface{}{
+		"Height": "1",
+	}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = sw.Call("SetRow", js.ValueOf("A"), js.ValueOf([]interface{}{1}))
+	assert.Equal(t, "cannot convert cell \"A\" to coordinates: invalid cell name \"A\"", ret.Get("error").String())
+
+	ret = sw.Call("SetColWidth", js.ValueOf(0), js.ValueOf(1), js.ValueOf(20))
+	assert.EqualError(t, excelize.ErrColumnNumber, ret.Get("error").String())
+
+	ret = sw.Call("AddTable", js.ValueOf(map[string]interface{}{"Range": "A1"}))
+	assert.EqualError(t, excelize.ErrParameterInvalid, ret.Get("error").String())
+
+	ret = sw.Call("InsertPageBreak", js.ValueOf("A"))
+	assert.Equal(t, "cannot convert cell \"A\" to coordinates: invalid cell name \"A\"", ret.Get("error").String())
+
+	ret = sw.Call("MergeCell", js.ValueOf("A"), js.ValueOf("B2"))
+	assert.Equal(t, "cannot convert cell \"A\" to coordinates: invalid cell name \"A\"", ret.Get("error").String())
+
+	ret = sw.Call("SetRow", js.ValueOf("A2"), js.ValueOf([]interface{}{1}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = sw.Call("SetPanes", js.ValueOf(map[string]interface{}{"Freeze": true}))
+	assert.Equal(t, "must call the SetPanes function before the SetRow function", ret.Get("error").String())
+
+	ret = sw.Call("SetColWidth", js.ValueOf(1), js.ValueOf(1), js.ValueOf(20))
+	assert.Equal(t, "must call the SetColWidth function before the SetRow function", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("NewStreamWriter", js.ValueOf("SheetN"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("NewStreamWriter")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+}
+
 func TestStyle(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -1826,6 +3351,29 @@ func TestStyle(t *testing.T) {
 	assert.True(t, ret.Get("style").Get("Font").Get("Bold").Bool())
 	assert.Equal(t, "single", ret.Get("style").Get("Font").Get("Underline").String())
 
+	// Test get the 64-bit integer fields as BigInt in the BigInt mode
+	for _, bigInt := range []bool{true, false} {
+		wb := NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"BigInt": bigInt})}).(js.Value)
+		assert.True(t, wb.Get("error").IsNull())
+		style := wb.Call("NewStyle", js.ValueOf(map[string]interface{}{
+			"Alignment": map[string]interface{}{"ReadingOrder": js.Global().Get("BigInt").Invoke("9007199254740993")},
+		}))
+		assert.True(t, style.Get("error").IsNull(), style.Get("error").String())
+		ret = wb.Call("GetStyle", style.Get("style"))
+		assert.True(t, ret.Get("error").IsNull())
+		readingOrder := ret.Get("style").Get("Alignment").Get("ReadingOrder")
+		if !bigInt {
+			assert.Equal(t, js.TypeNumber, readingOrder.Type())
+			continue
+		}
+		assert.Equal(t, jsTypeBigInt, jsType(readingOrder))
+		assert.Equal(t, "9007199254740993", js.Global().Get("String").Invoke(readingOrder).String())
+		assert.Equal(t, js.TypeNumber, ret.Get("style").Get("NumFmt").Type())
+	}
+	ret = NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"BigInt": 1})}).(js.Value)
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "BigInt", ret.Get("errorInfo").Get("path").String())
+
 	for _, arg := range []map[string]interface{}{
 		{"NumFmt": "1"},
 		{"DecimalPlaces": "2"},
@@ -1993,13 +3541,235 @@ func TestRemoveRow(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
 
-	ret := f.(js.Value).Call("RemoveRow", js.ValueOf("Sheet1"), js.ValueOf(1))
+	ret := f.(js.Value).Call("RemoveRow", js.ValueOf("Sheet1"), js.ValueOf(1))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("RemoveRow")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("RemoveRow", js.ValueOf("SheetN"), js.ValueOf(1))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
+func TestRenderHTML(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	for _, call := range [][]interface{}{
+		{"SetSheetRow", "Sheet1", "A1", []interface{}{"Title", nil, nil, "d"}},
+		{"SetSheetRow", "Sheet1", "A2", []interface{}{0.5, "<b>&", true}},
+		{"SetSheetRow", "Sheet1", "A4", []interface{}{"hidden"}},
+		{"MergeCell", "Sheet1", "A1", "B1"},
+		{"SetCellRichText", "Sheet1", "A3", []interface{}{
+			map[string]interface{}{"Text": "Red", "Font": map[string]interface{}{"Color": "FF0000", "Bold": true}},
+			map[string]interface{}{"Text": " plain"},
+		}},
+		{"SetCellValue", "Sheet1", "B3", "link"},
+		{"SetCellHyperLink", "Sheet1", "B3", "https://example.com/?a=1&b=2", "External"},
+		{"SetCellValue", "Sheet1", "C3", "script"},
+		{"SetCellHyperLink", "Sheet1", "C3", "javascript:alert(1)", "External"},
+		{"SetColWidth", "Sheet1", "A", "A", 20},
+		{"SetRowHeight", "Sheet1", 2, 30},
+		{"SetColVisible", "Sheet1", "D", false},
+		{"SetRowVisible", "Sheet1", 4, false},
+	} {
+		ret := f.(js.Value).Call(call[0].(string), call[1:]...)
+		assert.True(t, ret.Get("error").IsNull(), call[0])
+	}
+	ret := f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{
+		"Font":      map[string]interface{}{"Bold": true, "Italic": true, "Underline": "single", "Family": "Arial", "Size": 14},
+		"Fill":      map[string]interface{}{"Type": "pattern", "Pattern": 1, "Color": []interface{}{"FFFF00"}},
+		"Border":    []interface{}{map[string]interface{}{"Type": "bottom", "Color": "FF0000", "Style": 1}},
+		"Alignment": map[string]interface{}{"Horizontal": "center", "Vertical": "center", "WrapText": true},
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetCellStyle", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf("B1"), ret.Get("style"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"NumFmt": 10}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetCellStyle", js.ValueOf("Sheet1"), js.ValueOf("A2"), js.ValueOf("A2"), ret.Get("style"))
+	assert.True(t, ret.Get("error").IsNull())
+
+	// Test render the used range of the worksheet
+	ret = f.(js.Value).Call("RenderHTML", js.ValueOf("Sheet1"), js.ValueOf(""))
+	assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+	result := ret.Get("html").String()
+	assert.True(t, strings.HasPrefix(result, `<table style="border-collapse:collapse;table-layout:fixed;width:283px"><colgroup><col style="width:145px">`))
+	for _, expected := range []string{
+		`<td colspan="2" style="font-family:&#34;Arial&#34;;font-size:14pt;font-weight:bold;font-style:italic;text-decoration:underline;` +
+			`background-color:#FFFF00;border-bottom:1px solid #FF0000;text-align:center;vertical-align:middle;white-space:pre-wrap;overflow-wrap:break-word;">Title</td>`,
+		`<tr style="height:30pt">`,
+		`text-align:right;">50.00%</td>`,
+		`overflow:hidden;">&lt;b&gt;&amp;</td>`,
+		`text-align:center;">TRUE</td>`,
+		`<span style="font-weight:bold;color:#FF0000;">Red</span><span style=""> plain</span>`,
+		`<a href="https://example.com/?a=1&amp;b=2" target="_blank" rel="noopener noreferrer">link</a>`,
+		`<a href="#javascript:alert(1)">script</a>`,
+	} {
+		assert.Contains(t, result, expected)
+	}
+	assert.NotContains(t, result, "hidden</td>")
+	assert.NotContains(t, result, ">d</td>")
+	assert.Equal(t, 3, strings.Count(result, "<tr "))
+
+	// Test render the range with hidden rows and columns
+	ret = f.(js.Value).Call("RenderHTML", js.ValueOf("Sheet1"), js.ValueOf("D4:B1"), js.ValueOf(map[string]interface{}{"ShowHidden": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	result = ret.Get("html").String()
+	assert.Contains(t, result, "<colgroup><col style=\"width:69px\"><col style=\"width:69px\"><col style=\"width:69px\"></colgroup>")
+	assert.Contains(t, result, `<td style="font-family:&#34;Arial&#34;`)
+	assert.Contains(t, result, ">Title</td>")
+	assert.Contains(t, result, ">d</td>")
+	assert.Equal(t, 4, strings.Count(result, "<tr "))
+
+	// Test render and export the range after the worksheet data doesn't change
+	// the worksheet
+	ret = f.(js.Value).Call("SetColStyle", js.ValueOf("Sheet1"), js.ValueOf("C"), js.ValueOf(1))
+	assert.True(t, ret.Get("error").IsNull())
+	countRows := func() int {
+		rows := f.(js.Value).Call("Rows", js.ValueOf("Sheet1"))
+		assert.True(t, rows.Get("error").IsNull())
+		var count int
+		for rows.Call("Next").Bool() {
+			count++
+		}
+		assert.True(t, rows.Call("Close").Get("error").IsNull())
+		return count
+	}
+	rowCount, dimension := countRows(), f.(js.Value).Call("GetSheetDimension", js.ValueOf("Sheet1")).Get("dimension").String()
+	ret = f.(js.Value).Call("RenderHTML", js.ValueOf("Sheet1"), js.ValueOf("A1:D500"))
+	assert.True(t, ret.Get("error").IsNull())
+	result = ret.Get("html").String()
+	assert.Equal(t, 499, strings.Count(result, "<tr "))
+	assert.Equal(t, 500, strings.Count(result, "background-color:#FFFF00"))
+	assert.Contains(t, result, ">TRUE</td>")
+	ret = f.(js.Value).Call("ExportCSV", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Range": "A1:C200", "HiddenRows": "skip"}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, rowCount, countRows())
+	assert.Equal(t, dimension, f.(js.Value).Call("GetSheetDimension", js.ValueOf("Sheet1")).Get("dimension").String())
+	rows := f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"))
+	assert.True(t, rows.Get("error").IsNull())
+	assert.Equal(t, 4, rows.Get("result").Length())
+
+	// Test render the empty worksheet
+	ret = f.(js.Value).Call("NewSheet", js.ValueOf("Sheet2"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("RenderHTML", js.ValueOf("Sheet2"), js.ValueOf(""))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 1, strings.Count(ret.Get("html").String(), "<td "))
+
+	ret = f.(js.Value).Call("RenderHTML", js.ValueOf("Sheet1"), js.ValueOf("A"))
+	assert.Equal(t, "cannot convert cell \"A\" to coordinates: invalid cell name \"A\"", ret.Get("error").String())
+	ret = f.(js.Value).Call("RenderHTML", js.ValueOf("Sheet1"), js.ValueOf(""), js.ValueOf(map[string]interface{}{"ShowHidden": 1}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, "ShowHidden", ret.Get("errorInfo").Get("path").String())
+
+	ret = f.(js.Value).Call("RenderHTML")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	for _, ref := range []string{"", "A1"} {
+		ret = f.(js.Value).Call("RenderHTML", js.ValueOf("SheetN"), js.ValueOf(ref))
+		assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+		assert.Empty(t, ret.Get("html").String())
+	}
+}
+
+func TestRows(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	for r, row := range [][]interface{}{{"A", "B"}, {1, 2}, {3, 4}} {
+		ret := f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf(fmt.Sprintf("A%d", r+1)), js.ValueOf(row))
+		assert.True(t, ret.Get("error").IsNull())
+	}
+	ret := f.(js.Value).Call("SetRowHeight", js.ValueOf("Sheet1"), js.ValueOf(1), js.ValueOf(30))
+	assert.True(t, ret.Get("error").IsNull())
+
+	rows := f.(js.Value).Call("Rows", js.ValueOf("Sheet1"))
+	assert.True(t, rows.Get("error").IsNull())
+	var result [][]string
+	for rows.Call("Next").Bool() {
+		ret = rows.Call("Columns")
+		assert.True(t, ret.Get("error").IsNull())
+		var row []string
+		for c := 0; c < ret.Get("result").Length(); c++ {
+			row = append(row, ret.Get("result").Index(c).String())
+		}
+		result = append(result, row)
+		if len(result) == 1 {
+			ret = rows.Call("GetRowOpts")
+			assert.True(t, ret.Get("error").IsNull())
+			assert.Equal(t, 30, ret.Get("opts").Get("Height").Int())
+		}
+	}
+	assert.Equal(t, [][]string{{"A", "B"}, {"1", "2"}, {"3", "4"}}, result)
+	assert.True(t, rows.Call("Error").Get("error").IsNull())
+	assert.True(t, rows.Call("Close").Get("error").IsNull())
+
+	// Test call functions of the finished rows iterator
+	assert.False(t, rows.Call("Next").Bool())
+	ret = rows.Call("Columns")
+	assert.EqualError(t, errObjectClosed, ret.Get("error").String())
+	assert.Equal(t, "ERR_OBJECT_CLOSED", ret.Get("errorInfo").Get("code").String())
+	item, err := awaitPromise(asyncIterator(rows).Call("next"))
+	assert.NoError(t, err)
+	assert.True(t, item.Get("done").Bool())
+
+	// Test iterate rows with the async iterator
+	rows = f.(js.Value).Call("Rows", js.ValueOf("Sheet1"))
+	assert.True(t, rows.Get("error").IsNull())
+	iterator := asyncIterator(rows)
+	result = nil
+	for {
+		item, err := awaitPromise(iterator.Call("next"))
+		assert.NoError(t, err)
+		if item.Get("done").Bool() {
+			break
+		}
+		var row []string
+		for c := 0; c < item.Get("value").Length(); c++ {
+			row = append(row, item.Get("value").Index(c).String())
+		}
+		result = append(result, row)
+	}
+	assert.Equal(t, [][]string{{"A", "B"}, {"1", "2"}, {"3", "4"}}, result)
+	assert.True(t, rows.Call("Close").Get("error").IsNull())
+
+	// Test break out of the async iterator
+	rows = f.(js.Value).Call("Rows", js.ValueOf("Sheet1"))
+	assert.True(t, rows.Get("error").IsNull())
+	iterator = asyncIterator(rows)
+	item, err = awaitPromise(iterator.Call("next"))
+	assert.NoError(t, err)
+	assert.False(t, item.Get("done").Bool())
+	item, err = awaitPromise(iterator.Call("return"))
+	assert.NoError(t, err)
+	assert.True(t, item.Get("done").Bool())
+	assert.False(t, rows.Call("Next").Bool())
+	item, err = awaitPromise(iterator.Call("next"))
+	assert.NoError(t, err)
+	assert.True(t, item.Get("done").Bool())
+
+	// Test rows iterator with invalid arguments
+	rows = f.(js.Value).Call("Rows", js.ValueOf("Sheet1"))
+	assert.True(t, rows.Get("error").IsNull())
+	assert.True(t, rows.Call("Next").Bool())
+	ret = rows.Call("Columns", js.ValueOf(map[string]interface{}{"RawCellValue": true}))
 	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 2, ret.Get("result").Length())
 
-	ret = f.(js.Value).Call("RemoveRow")
+	ret = rows.Call("Columns", js.ValueOf(map[string]interface{}{"RawCellValue": "true"}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = rows.Call("Columns", js.ValueOf(true))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = rows.Call("Close", js.ValueOf(true))
 	assert.EqualError(t, errArgNum, ret.Get("error").String())
 
-	ret = f.(js.Value).Call("RemoveRow", js.ValueOf("SheetN"), js.ValueOf(1))
+	ret = f.(js.Value).Call("Rows")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("Rows", js.ValueOf("SheetN"))
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
 }
 
@@ -2178,6 +3948,71 @@ func TestSetCellInt(t *testing.T) {
 
 	ret = f.(js.Value).Call("SetCellInt", js.ValueOf("SheetN"), js.ValueOf("A1"), js.ValueOf(1))
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	bigInt := js.Global().Get("BigInt")
+	ret = f.(js.Value).Call("SetCellInt", js.ValueOf("Sheet1"), js.ValueOf("A2"), bigInt.Invoke("-9223372036854775808"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetCellInt", js.ValueOf("Sheet1"), js.ValueOf("A3"), bigInt.Invoke("9007199254740993"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A2"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "-9223372036854775808", ret.Get("value").String())
+
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": true, "BigInt": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 1.0, ret.Get("result").Index(0).Index(0).Float())
+	assertBigInt(t, "-9223372036854775808", ret.Get("result").Index(1).Index(0))
+	assertBigInt(t, "9007199254740993", ret.Get("result").Index(2).Index(0))
+
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A3"), js.ValueOf(map[string]interface{}{"Typed": true, "BigInt": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assertBigInt(t, "9007199254740993", ret.Get("value"))
+
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": true, "BigInt": 1}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCellInt", js.ValueOf("Sheet1"), js.ValueOf("A4"), bigInt.Invoke("9223372036854775808"))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, 2, ret.Get("errorInfo").Get("argIndex").Int())
+
+	ret = f.(js.Value).Call("SetCellInt", js.ValueOf("Sheet1"), js.ValueOf("A4"), js.ValueOf("1"))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+}
+
+func TestSetCellUint(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	bigInt := js.Global().Get("BigInt")
+	ret := f.(js.Value).Call("SetCellUint", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf(1))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("SetCellUint", js.ValueOf("Sheet1"), js.ValueOf("A2"), bigInt.Invoke("18446744073709551615"))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A2"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "18446744073709551615", ret.Get("value").String())
+
+	ret = f.(js.Value).Call("GetCols", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": true, "BigInt": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 1.0, ret.Get("result").Index(0).Index(0).Float())
+	assertBigInt(t, "18446744073709551615", ret.Get("result").Index(0).Index(1))
+
+	ret = f.(js.Value).Call("GetCols", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Typed": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 18446744073709551615.0, ret.Get("result").Index(0).Index(1).Float())
+
+	ret = f.(js.Value).Call("SetCellUint")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCellUint", js.ValueOf("SheetN"), js.ValueOf("A1"), js.ValueOf(1))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	for _, value := range []js.Value{js.ValueOf(-1), bigInt.Invoke(-1), bigInt.Invoke("18446744073709551616")} {
+		ret = f.(js.Value).Call("SetCellUint", js.ValueOf("Sheet1"), js.ValueOf("A3"), value)
+		assert.EqualError(t, errArgType, ret.Get("error").String())
+		assert.Equal(t, 2, ret.Get("errorInfo").Get("argIndex").Int())
+	}
 }
 
 func TestCellRichText(t *testing.T) {
@@ -2259,6 +4094,25 @@ func TestSetCellValue(t *testing.T) {
 	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A2"), js.ValueOf(true))
 	assert.True(t, ret.Get("error").IsNull())
 
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A3"), js.Global().Get("Date").New(2024, 0, 1, 12))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A3"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "45292.5", ret.Get("value").String())
+
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A4"), js.ValueOf(map[string]interface{}{}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A4"), js.Global().Get("BigInt").Invoke("9007199254740993"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A4"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "9007199254740993", ret.Get("value").String())
+
+	ret = f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A5"), js.Global().Get("BigInt").Invoke("18446744073709551616"))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
 	ret = f.(js.Value).Call("SetCellValue")
 	assert.EqualError(t, errArgNum, ret.Get("error").String())
 
@@ -2266,6 +4120,116 @@ func TestSetCellValue(t *testing.T) {
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
 }
 
+func TestSetCells(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	ret := f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"NumFmt": 9}))
+	assert.True(t, ret.Get("error").IsNull())
+	styleID := ret.Get("style").Int()
+
+	// Test set cells by the records
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf([]interface{}{
+		map[string]interface{}{"Cell": "A1", "Value": "value"},
+		map[string]interface{}{"Cell": "B1", "Value": 0.5, "StyleID": styleID},
+		map[string]interface{}{"Cell": "C1", "Formula": "B1*2"},
+		map[string]interface{}{"Cell": "D1", "Value": js.Global().Get("Date").New(2024, 0, 2)},
+		map[string]interface{}{"Cell": "E1", "Value": true},
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 0, ret.Get("errors").Length())
+	for cell, expected := range map[string]string{"A1": "value", "B1": "50%", "D1": "01-02-24", "E1": "TRUE"} {
+		ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf(cell))
+		assert.Equal(t, expected, ret.Get("value").String())
+	}
+	ret = f.(js.Value).Call("GetCellFormula", js.ValueOf("Sheet1"), js.ValueOf("C1"))
+	assert.Equal(t, "B1*2", ret.Get("formula").String())
+
+	// Test set cells by the object keyed by the cell reference
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{
+		"A2": 1,
+		"B2": map[string]interface{}{"StyleID": styleID},
+		"C2": nil,
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 0, ret.Get("errors").Length())
+	ret = f.(js.Value).Call("GetCellStyle", js.ValueOf("Sheet1"), js.ValueOf("B2"))
+	assert.Equal(t, styleID, ret.Get("style").Int())
+
+	// Test set cells by the 2D block anchored at the top-left cell
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf("B3"), js.ValueOf([]interface{}{
+		[]interface{}{"x", 1},
+		[]interface{}{map[string]interface{}{"Value": 0.25, "StyleID": styleID}, false},
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 0, ret.Get("errors").Length())
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"))
+	assert.Equal(t, "x", ret.Get("result").Index(2).Index(1).String())
+	assert.Equal(t, "1", ret.Get("result").Index(2).Index(2).String())
+	assert.Equal(t, "25%", ret.Get("result").Index(3).Index(1).String())
+	assert.Equal(t, "FALSE", ret.Get("result").Index(3).Index(2).String())
+
+	// Test set cells with the failing entries
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf([]interface{}{
+		map[string]interface{}{"Cell": "A0", "Value": 1},
+		map[string]interface{}{"Value": 1},
+		map[string]interface{}{"Cell": "A5", "StyleID": "1"},
+		map[string]interface{}{"Cell": "B5", "Value": map[string]interface{}{}},
+		map[string]interface{}{"Cell": "C5", "Value": "value"},
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	errs := ret.Get("errors")
+	assert.Equal(t, 4, errs.Length())
+	for i, expected := range []struct{ cell, err, code, path string }{
+		{"A0", "cannot convert cell \"A0\" to coordinates: invalid cell name \"A0\"", "ERR_UNKNOWN", "[0]"},
+		{"", errArgType.Error(), "ERR_ARG_TYPE", "[1].Cell"},
+		{"A5", errArgType.Error(), "ERR_ARG_TYPE", "[2].StyleID"},
+		{"B5", errArgType.Error(), "ERR_ARG_TYPE", "[3].Value"},
+	} {
+		assert.Equal(t, expected.cell, errs.Index(i).Get("cell").String())
+		assert.Equal(t, expected.err, errs.Index(i).Get("error").String())
+		assert.Equal(t, expected.code, errs.Index(i).Get("errorInfo").Get("code").String())
+		assert.Equal(t, expected.path, errs.Index(i).Get("errorInfo").Get("path").String())
+		assert.Equal(t, 1, errs.Index(i).Get("errorInfo").Get("argIndex").Int())
+	}
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("C5"))
+	assert.Equal(t, "value", ret.Get("value").String())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"A0": 1, "A6": js.FuncOf(nil)}))
+	assert.Equal(t, 2, ret.Get("errors").Length())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf("XFD1"), js.ValueOf([]interface{}{[]interface{}{1, 2}}))
+	assert.Equal(t, 1, ret.Get("errors").Length())
+	assert.Equal(t, "[0][1]", ret.Get("errors").Index(0).Get("errorInfo").Get("path").String())
+	assert.Equal(t, 2, ret.Get("errors").Index(0).Get("errorInfo").Get("argIndex").Int())
+
+	// Test set cells with invalid arguments
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"))
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf([]interface{}{}), js.ValueOf([]interface{}{}))
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf(true))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf([]interface{}{1}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	assert.Equal(t, 2, ret.Get("errorInfo").Get("argIndex").Int())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet1"), js.ValueOf("A"), js.ValueOf([]interface{}{}))
+	assert.Equal(t, "cannot convert cell \"A\" to coordinates: invalid cell name \"A\"", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("SheetN"), js.ValueOf([]interface{}{}))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetCells", js.ValueOf("Sheet:1"), js.ValueOf([]interface{}{}))
+	assert.EqualError(t, excelize.ErrSheetNameInvalid, ret.Get("error").String())
+}
+
 func TestSetColOutlineLevel(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -2344,6 +4308,79 @@ func TestSetConditionalFormat(t *testing.T) {
 
 	ret = f.(js.Value).Call("SetConditionalFormat", js.ValueOf("SheetN"), js.ValueOf("A1:B2"), condFmt)
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetConditionalFormats", js.ValueOf("Sheet1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 1, ret.Get("formats").Get("A1:B2").Length())
+	assert.Equal(t, "top", ret.Get("formats").Get("A1:B2").Index(0).Get("Type").String())
+
+	ret = f.(js.Value).Call("NewSheet", js.ValueOf("Sheet2"))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetConditionalFormats", js.ValueOf("Sheet2"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 0, js.Global().Get("Object").Call("keys", ret.Get("formats")).Length())
+
+	ret = f.(js.Value).Call("GetConditionalFormats")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("GetConditionalFormats", js.ValueOf("SheetN"))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
+func TestGetConditionalFormats(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+
+	style := f.(js.Value).Call("NewConditionalStyle", js.ValueOf(map[string]interface{}{
+		"Font": map[string]interface{}{"Color": "9A0511"},
+	}))
+	assert.True(t, style.Get("error").IsNull())
+	ret := f.(js.Value).Call("SetConditionalFormat", js.ValueOf("Sheet1"), js.ValueOf("A1:B2"), js.ValueOf([]interface{}{
+		map[string]interface{}{"Type": "cell", "Criteria": ">", "Format": style.Get("style"), "Value": "6"},
+		map[string]interface{}{"Type": "duplicate", "Criteria": "=", "Format": style.Get("style"), "StopIfTrue": true},
+	}))
+	assert.True(t, ret.Get("error").IsNull(), ret.Get("error").String())
+	ret = f.(js.Value).Call("SetConditionalFormat", js.ValueOf("Sheet1"), js.ValueOf("D1:D10"), js.ValueOf([]interface{}{
+		map[string]interface{}{"Type": "data_bar", "Criteria": "=", "MinType": "min", "MaxType": "max", "BarColor": "#638EC6"},
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("GetConditionalFormats", js.ValueOf("Sheet1"))
+	assert.True(t, ret.Get("error").IsNull())
+	formats := ret.Get("formats")
+	assert.Equal(t, 2, js.Global().Get("Object").Call("keys", formats).Length())
+	assert.Equal(t, 2, formats.Get("A1:B2").Length())
+	assert.Equal(t, "cell", formats.Get("A1:B2").Index(0).Get("Type").String())
+	assert.Equal(t, "greater than", formats.Get("A1:B2").Index(0).Get("Criteria").String())
+	assert.Equal(t, "6", formats.Get("A1:B2").Index(0).Get("Value").String())
+	assert.Equal(t, style.Get("style").Int(), formats.Get("A1:B2").Index(0).Get("Format").Int())
+	assert.Equal(t, "duplicate", formats.Get("A1:B2").Index(1).Get("Type").String())
+	assert.True(t, formats.Get("A1:B2").Index(1).Get("StopIfTrue").Bool())
+	assert.Equal(t, "data_bar", formats.Get("D1:D10").Index(0).Get("Type").String())
+	assert.Equal(t, "#638EC6", formats.Get("D1:D10").Index(0).Get("BarColor").String())
+
+	// Test copy the conditional formats to another worksheet
+	ret = f.(js.Value).Call("NewSheet", js.ValueOf("Sheet2"))
+	assert.True(t, ret.Get("error").IsNull())
+	for _, ref := range []string{"A1:B2", "D1:D10"} {
+		ret = f.(js.Value).Call("SetConditionalFormat", js.ValueOf("Sheet2"), js.ValueOf(ref), formats.Get(ref))
+		assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+	}
+	ret = f.(js.Value).Call("GetConditionalFormats", js.ValueOf("Sheet2"))
+	assert.True(t, ret.Get("error").IsNull())
+	copied := ret.Get("formats")
+	assert.Equal(t, 2, js.Global().Get("Object").Call("keys", copied).Length())
+	for _, ref := range []string{"A1:B2", "D1:D10"} {
+		assert.Equal(t, formats.Get(ref).Length(), copied.Get(ref).Length(), ref)
+		for i := 0; i < formats.Get(ref).Length(); i++ {
+			for _, field := range []string{"Type", "Criteria", "Value", "Format", "StopIfTrue", "MinType", "MaxType", "BarColor"} {
+				assert.Equal(t, formats.Get(ref).Index(i).Get(field).String(), copied.Get(ref).Index(i).Get(field).String(), "%s[%d].%s", ref, i, field)
+			}
+		}
+	}
+
+	ret = f.(js.Value).Call("GetConditionalFormats", js.ValueOf(true))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
 }
 
 func TestCustomProps(t *testing.T) {
@@ -2357,17 +4394,22 @@ func TestCustomProps(t *testing.T) {
 		map[string]interface{}{"Name": "Number Prop 1", "Value": -123.456},
 		map[string]interface{}{"Name": "Number Prop 2", "Value": 1},
 		map[string]interface{}{"Name": "Number Prop 2", "Value": nil},
+		map[string]interface{}{"Name": "Date Prop", "Value": js.Global().Get("Date").New("2019-06-04T22:00:10Z")},
 	} {
 		ret := f.(js.Value).Call("SetCustomProps", js.ValueOf(prop))
 		assert.True(t, ret.Get("error").IsNull())
 	}
 
 	ret := f.(js.Value).Call("GetCustomProps")
-	assert.Equal(t, ret.Get("props").Length(), 4)
+	assert.Equal(t, ret.Get("props").Length(), 5)
 	assert.Equal(t, ret.Get("props").Index(0).Get("Value").String(), "text")
 	assert.True(t, ret.Get("props").Index(1).Get("Value").Bool())
 	assert.False(t, ret.Get("props").Index(2).Get("Value").Bool())
 	assert.Equal(t, ret.Get("props").Index(3).Get("Value").Float(), -123.456)
+	assert.Equal(t, "2019-06-04T22:00:10.000Z", ret.Get("props").Index(4).Get("Value").Call("toISOString").String())
+
+	ret = f.(js.Value).Call("SetCustomProps", js.ValueOf(map[string]interface{}{"Name": "Prop", "Value": map[string]interface{}{}}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
 
 	ret = f.(js.Value).Call("SetCustomProps")
 	assert.EqualError(t, errArgNum, ret.Get("error").String())
@@ -2453,12 +4495,16 @@ func TestDocProps(t *testing.T) {
 
 	ret := f.(js.Value).Call("SetDocProps", js.ValueOf(map[string]interface{}{
 		"Category": "category",
+		"Created":  js.Global().Get("Date").New("2019-06-04T22:00:10Z"),
+		"Modified": "2019-06-04T22:00:10Z",
 	}))
 	assert.True(t, ret.Get("error").IsNull())
 
 	ret = f.(js.Value).Call("GetDocProps")
 	assert.True(t, ret.Get("error").IsNull())
 	assert.Equal(t, "category", ret.Get("props").Get("Category").String())
+	assert.Equal(t, "2019-06-04T22:00:10Z", ret.Get("props").Get("Created").String())
+	assert.Equal(t, "2019-06-04T22:00:10Z", ret.Get("props").Get("Modified").String())
 
 	ret = f.(js.Value).Call("SetDocProps")
 	assert.EqualError(t, errArgNum, ret.Get("error").String())
@@ -2639,6 +4685,108 @@ func TestPanes(t *testing.T) {
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
 }
 
+func TestSetRecords(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	ret := f.(js.Value).Call("NewStyle", js.ValueOf(map[string]interface{}{"Font": map[string]interface{}{"Bold": true}}))
+	assert.True(t, ret.Get("error").IsNull())
+	style := ret.Get("style").Int()
+	date := js.Global().Get("Date").New(2024, 0, 15)
+
+	// Test set records with header style, number formats and table
+	ret = f.(js.Value).Call("SetRecords", js.ValueOf("Sheet1"), js.ValueOf("B2"), js.ValueOf([]interface{}{
+		map[string]interface{}{"Name": "Bob", "Amount": 1234.5, "Active": true, "Date": date},
+		map[string]interface{}{"Name": "Amy", "Amount": 3, "Extra": "e", "Date": nil},
+		map[string]interface{}{},
+	}), js.ValueOf(map[string]interface{}{
+		"Columns":       []interface{}{"Name", "Amount", "Active", "Date", "Extra"},
+		"HeaderStyle":   style,
+		"AsTable":       map[string]interface{}{"Name": "Sales"},
+		"NumberFormats": map[string]interface{}{"Amount": "#,##0.00", "Date": 14},
+	}))
+	assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, `[[],["","Name","Amount","Active","Date","Extra"],["","Bob","1,234.50","TRUE","01-15-24"],["","Amy","3.00","","","e"]]`,
+		js.Global().Get("JSON").Call("stringify", ret.Get("result")).String())
+	ret = f.(js.Value).Call("GetCellStyle", js.ValueOf("Sheet1"), js.ValueOf("F2"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, style, ret.Get("style").Int())
+	ret = f.(js.Value).Call("GetTables", js.ValueOf("Sheet1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, 1, ret.Get("tables").Length())
+	assert.Equal(t, "Sales", ret.Get("tables").Index(0).Get("Name").String())
+	assert.Equal(t, "B2:F5", ret.Get("tables").Index(0).Get("Range").String())
+	ret = f.(js.Value).Call("GetRecords", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"Range": "B2:F5", "Typed": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assertRecord(t, map[string]interface{}{
+		"Name": "Bob", "Amount": 1234.5, "Active": true, "Date": date, "Extra": nil,
+	}, ret.Get("records").Index(0))
+
+	// Test set records with the columns of the records and empty records
+	for _, c := range []struct {
+		records  []interface{}
+		opts     map[string]interface{}
+		expected string
+	}{
+		{[]interface{}{map[string]interface{}{"A": 1}, map[string]interface{}{"B": "b", "A": 2}}, nil, `[["A","B"],["1"],["2","b"]]`},
+		{[]interface{}{}, map[string]interface{}{"Columns": []interface{}{"A", "B"}, "AsTable": map[string]interface{}{}}, `[["A","B"]]`},
+		{[]interface{}{}, nil, `[]`},
+	} {
+		f = NewFile(js.Value{}, []js.Value{})
+		args := []interface{}{"Sheet1", "A1", c.records}
+		if c.opts != nil {
+			args = append(args, c.opts)
+		}
+		ret = f.(js.Value).Call("SetRecords", args...)
+		assert.True(t, ret.Get("error").IsNull(), ret.Get("error"))
+		ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"))
+		assert.True(t, ret.Get("error").IsNull())
+		assert.Equal(t, c.expected, js.Global().Get("JSON").Call("stringify", ret.Get("result")).String())
+	}
+	ret = f.(js.Value).Call("GetTables", js.ValueOf("Sheet1"))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Zero(t, ret.Get("tables").Length())
+
+	// Test set records with invalid records and options
+	for _, c := range []struct {
+		records  interface{}
+		opts     map[string]interface{}
+		err      error
+		argIndex int
+		path     string
+	}{
+		{map[string]interface{}{}, nil, errArgType, 2, ""},
+		{[]interface{}{1}, nil, errArgType, 2, "[0]"},
+		{[]interface{}{map[string]interface{}{"A": map[string]interface{}{}}}, nil, errArgType, 2, "[0].A"},
+		{[]interface{}{}, map[string]interface{}{"NumberFormats": true}, errArgType, 3, "NumberFormats"},
+		{[]interface{}{}, map[string]interface{}{"NumberFormats": map[string]interface{}{"A": true}}, errArgType, 3, "NumberFormats.A"},
+		{[]interface{}{}, map[string]interface{}{"Columns": []interface{}{"B"}, "NumberFormats": map[string]interface{}{"A": 1}}, errArgValue, 3, "NumberFormats.A"},
+		{[]interface{}{}, map[string]interface{}{"AsTable": map[string]interface{}{"Name": 1}}, errArgType, 3, "AsTable.Name"},
+	} {
+		args := []interface{}{"Sheet1", "A1", c.records}
+		if c.opts != nil {
+			args = append(args, c.opts)
+		}
+		ret = f.(js.Value).Call("SetRecords", args...)
+		assert.EqualError(t, c.err, ret.Get("error").String())
+		assert.Equal(t, c.argIndex, ret.Get("errorInfo").Get("argIndex").Int())
+		if c.path != "" {
+			assert.Equal(t, c.path, ret.Get("errorInfo").Get("path").String())
+		}
+	}
+	ret = f.(js.Value).Call("SetRecords", js.ValueOf("Sheet1"), js.ValueOf("A"), js.ValueOf([]interface{}{}))
+	assert.Equal(t, "cannot convert cell \"A\" to coordinates: invalid cell name \"A\"", ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetRecords")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetRecords", js.ValueOf("SheetN"), js.ValueOf("A1"), js.ValueOf([]interface{}{map[string]interface{}{"A": 1}}))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+	ret = f.(js.Value).Call("SetRecords", js.ValueOf("SheetN"), js.ValueOf("A1"), js.ValueOf([]interface{}{}))
+	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+}
+
 func TestSetRowHeight(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -2715,15 +4863,36 @@ func TestSetSheetBackgroundFromBytes(t *testing.T) {
 
 	ret = f.(js.Value).Call("SetSheetBackgroundFromBytes", js.ValueOf("Sheet1"), js.ValueOf(".images"), js.ValueOf(uint8Array))
 	assert.EqualError(t, excelize.ErrImgExt, ret.Get("error").String())
+
+	ret = f.(js.Value).Call("SetSheetBackgroundFromBytes", js.ValueOf("Sheet1"), js.ValueOf(".png"), uint8Array.Get("buffer"))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret, err = awaitPromise(f.(js.Value).Call("SetSheetBackgroundFromBytes", js.ValueOf("Sheet1"), js.ValueOf(".png"),
+		js.Global().Get("Blob").New(js.ValueOf([]interface{}{uint8Array}))))
+	assert.NoError(t, err)
+	assert.True(t, ret.Get("error").IsNull())
+
+	// Test set the background from the ReadableStream in the exception mode
+	f = NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"ThrowOnError": true})})
+	_, err = awaitPromise(f.(js.Value).Call("SetSheetBackgroundFromBytes", js.ValueOf("SheetN"), js.ValueOf(".png"), newReadableStream(uint8Array)))
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	ret, err = awaitPromise(f.(js.Value).Call("SetSheetBackgroundFromBytes", js.ValueOf("Sheet1"), js.ValueOf(".png"), newReadableStream(uint8Array)))
+	assert.NoError(t, err)
+	assert.True(t, ret.IsUndefined())
 }
 
 func TestSetSheetCol(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
 
-	ret := f.(js.Value).Call("SetSheetCol", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf([]interface{}{"foo", 1, true, nil}))
+	ret := f.(js.Value).Call("SetSheetCol", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf([]interface{}{"foo", 1, true, nil,
+		js.Global().Get("Date").New(2024, 0, 1)}))
 	assert.True(t, ret.Get("error").IsNull())
 
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A5"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "45292", ret.Get("value").String())
+
 	ret = f.(js.Value).Call("SetSheetCol")
 	assert.EqualError(t, errArgNum, ret.Get("error").String())
 
@@ -2788,14 +4957,29 @@ func TestSetSheetRow(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
 
-	ret := f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf([]interface{}{"foo", 1, true, nil}))
+	ret := f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf([]interface{}{"foo", 1, true, nil,
+		js.Global().Get("Date").New(2024, 0, 1)}))
+	assert.True(t, ret.Get("error").IsNull())
+
+	ret = f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("E1"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
 	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "45292", ret.Get("value").String())
 
 	ret = f.(js.Value).Call("SetSheetRow")
 	assert.EqualError(t, errArgNum, ret.Get("error").String())
 
 	ret = f.(js.Value).Call("SetSheetRow", js.ValueOf("SheetN"), js.ValueOf("A1"), js.ValueOf([]interface{}{"foo", 1, true, nil}))
 	assert.Equal(t, "sheet SheetN does not exist", ret.Get("error").String())
+
+	bigInt := js.Global().Get("BigInt")
+	ret = f.(js.Value).Call("SetSheetRow", js.ValueOf("Sheet1"), js.ValueOf("A2"), js.ValueOf([]interface{}{
+		bigInt.Invoke("9223372036854775807"), bigInt.Invoke("18446744073709551615"),
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	ret = f.(js.Value).Call("GetRows", js.ValueOf("Sheet1"), js.ValueOf(map[string]interface{}{"RawCellValue": true}))
+	assert.True(t, ret.Get("error").IsNull())
+	assert.Equal(t, "9223372036854775807", ret.Get("result").Index(1).Index(0).String())
+	assert.Equal(t, "18446744073709551615", ret.Get("result").Index(1).Index(1).String())
 }
 
 func TestSheetView(t *testing.T) {
@@ -2981,6 +5165,127 @@ func TestUpdateLinkedValue(t *testing.T) {
 	assert.Equal(t, ret.Get("error").String(), "XML syntax error on line 1: invalid UTF-8")
 }
 
+func TestWriteTo(t *testing.T) {
+	f := NewFile(js.Value{}, []js.Value{})
+	assert.True(t, f.(js.Value).Get("error").IsNull())
+	ret := f.(js.Value).Call("SetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"), js.ValueOf("Hello"))
+	assert.True(t, ret.Get("error").IsNull())
+	reopen := func(data js.Value) {
+		f := OpenReader(js.Value{}, []js.Value{data})
+		assert.True(t, f.(js.Value).Get("error").IsNull())
+		ret := f.(js.Value).Call("GetCellValue", js.ValueOf("Sheet1"), js.ValueOf("A1"))
+		assert.Equal(t, "Hello", ret.Get("value").String())
+	}
+
+	// Test write the workbook to the callback function
+	var chunks []interface{}
+	onChunk := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		assert.LessOrEqual(t, args[0].Get("length").Int(), jsChunkSize)
+		chunks = append(chunks, args[0])
+		return nil
+	})
+	defer onChunk.Release()
+	ret = f.(js.Value).Call("WriteTo", onChunk.Value)
+	assert.True(t, ret.Get("error").IsNull())
+	data := js.Global().Get("Buffer").Call("concat", js.ValueOf(chunks))
+	assert.Equal(t, data.Get("length").Int(), ret.Get("bytes").Int())
+	reopen(data)
+
+	// Test write the encrypted workbook to the callback function
+	chunks = nil
+	ret = f.(js.Value).Call("WriteTo", onChunk.Value, js.ValueOf(map[string]interface{}{"Password": "password"}))
+	assert.True(t, ret.Get("error").IsNull())
+	data = js.Global().Get("Buffer").Call("concat", js.ValueOf(chunks))
+	encrypted := OpenReader(js.Value{}, []js.Value{data, js.ValueOf(map[string]interface{}{"Password": "password"})})
+	assert.True(t, encrypted.(js.Value).Get("error").IsNull())
+
+	// Test write the workbook to the callback function which throws an error
+	throws := js.Global().Get("Function").New("throw new Error('write failed')")
+	ret = f.(js.Value).Call("WriteTo", throws)
+	assert.Equal(t, "write failed", ret.Get("error").String())
+
+	// Test write the workbook to the WritableStream
+	sink := js.Global().Get("Function").New(
+		"const chunks = []; return { chunks, stream: new WritableStream({ write(chunk) { chunks.push(chunk); } }, { highWaterMark: 1 }) };",
+	).Invoke()
+	promise := f.(js.Value).Call("WriteTo", sink.Get("stream"))
+	assert.True(t, promise.InstanceOf(js.Global().Get("Promise")))
+	ret, err := awaitPromise(promise)
+	assert.NoError(t, err)
+	assert.True(t, ret.Get("error").IsNull())
+	data = js.Global().Get("Buffer").Call("concat", sink.Get("chunks"))
+	assert.Equal(t, data.Get("length").Int(), ret.Get("bytes").Int())
+	reopen(data)
+
+	// Test write the workbook to the locked and the erroring WritableStream
+	ret = f.(js.Value).Call("WriteTo", sink.Get("stream"))
+	assert.Equal(t, js.TypeString, ret.Get("error").Type())
+	assert.Equal(t, 0, ret.Get("errorInfo").Get("argIndex").Int())
+	ret, err = awaitPromise(f.(js.Value).Call("WriteTo", js.Global().Get("Function").New(
+		"return new WritableStream({ write() { throw new Error('write failed'); } });",
+	).Invoke()))
+	assert.NoError(t, err)
+	assert.Equal(t, "write failed", ret.Get("error").String())
+
+	// Test write the workbook to the Node.js writable stream with backpressure
+	sink = js.Global().Get("Function").New(
+		"const { Writable } = require('stream'); const chunks = []; return { chunks, stream: new Writable({ highWaterMark: 1024, write(chunk, encoding, callback) { chunks.push(chunk); setImmediate(callback); } }) };",
+	).Invoke()
+	promise = f.(js.Value).Call("WriteTo", sink.Get("stream"))
+	// Test call the functions of the workbook while it is being written
+	for _, args := range [][]interface{}{
+		{"WriteToBuffer"},
+		{"SetCellValue", "Sheet1", "A1", "World"},
+		{"WriteTo", onChunk.Value},
+	} {
+		ret = f.(js.Value).Call(args[0].(string), args[1:]...)
+		assert.EqualError(t, errWorkbookBusy, ret.Get("error").String())
+		assert.Equal(t, "ERR_WORKBOOK_BUSY", ret.Get("errorInfo").Get("code").String())
+	}
+	ret, err = awaitPromise(promise)
+	assert.NoError(t, err)
+	assert.True(t, ret.Get("error").IsNull())
+	data = js.Global().Get("Buffer").Call("concat", sink.Get("chunks"))
+	assert.Equal(t, data.Get("length").Int(), ret.Get("bytes").Int())
+	assert.True(t, sink.Get("stream").Get("writableFinished").Bool())
+	reopen(data)
+	ret = f.(js.Value).Call("WriteToBuffer")
+	assert.True(t, ret.Get("error").IsNull())
+	reopen(ret.Get("buffer"))
+
+	// Test write the workbook to the Node.js writable stream with an error
+	ret, err = awaitPromise(f.(js.Value).Call("WriteTo", js.Global().Get("Function").New(
+		"const { Writable } = require('stream'); return new Writable({ write(chunk, encoding, callback) { callback(new Error('write failed')); } });",
+	).Invoke()))
+	assert.NoError(t, err)
+	assert.Equal(t, "write failed", ret.Get("error").String())
+
+	// Test cancel writing the workbook to the WritableStream by the signal
+	progress := newProgressRecorder("zip:2")
+	ret, err = awaitPromise(f.(js.Value).Call("WriteTo", js.Global().Get("Function").New("return new WritableStream();").Invoke(),
+		js.ValueOf(map[string]interface{}{"OnProgress": progress.Get("onProgress"), "Signal": progress.Get("controller").Get("signal")})))
+	assert.NoError(t, err)
+	assert.EqualError(t, errCancelled, ret.Get("error").String())
+	assert.Equal(t, "zip:0 zip:1 zip:2", progress.Get("events").Call("map",
+		js.Global().Get("Function").New("event", "return event.split(':').slice(0, 2).join(':')")).Call("join", " ").String())
+
+	// Test write the workbook with invalid arguments
+	ret = f.(js.Value).Call("WriteTo", js.ValueOf(map[string]interface{}{}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	ret = f.(js.Value).Call("WriteTo", onChunk.Value, js.ValueOf(map[string]interface{}{"Password": true}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
+	ret = f.(js.Value).Call("WriteTo")
+	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	// Test write the workbook in the exception mode
+	f = NewFile(js.Value{}, []js.Value{js.ValueOf(map[string]interface{}{"ThrowOnError": true})})
+	_, err = awaitPromise(f.(js.Value).Call("WriteTo", js.Global().Get("Function").New(
+		"return new WritableStream({ write() { throw new Error('write failed'); } });",
+	).Invoke()))
+	assert.EqualError(t, err, "write failed")
+	assert.EqualError(t, catchError(func() { f.(js.Value).Call("WriteTo", throws) }), "JavaScript error: write failed")
+}
+
 func TestWriteToBuffer(t *testing.T) {
 	f := NewFile(js.Value{}, []js.Value{})
 	assert.True(t, f.(js.Value).Get("error").IsNull())
@@ -3000,6 +5305,32 @@ func TestWriteToBuffer(t *testing.T) {
 
 	ret = f.(js.Value).Call("WriteToBuffer", js.ValueOf(true), js.ValueOf(true))
 	assert.EqualError(t, errArgNum, ret.Get("error").String())
+
+	// Test write the workbook with the progress callback function
+	progress := newProgressRecorder("")
+	ret = f.(js.Value).Call("WriteToBuffer", js.ValueOf(map[string]interface{}{
+		"OnProgress": progress.Get("onProgress"), "Signal": progress.Get("controller").Get("signal"),
+	}))
+	assert.True(t, ret.Get("error").IsNull())
+	events := progress.Get("events")
+	assert.Equal(t, "zip:0:", events.Index(0).String()[:6])
+	parts := events.Get("length").Int() - 1
+	assert.Equal(t, fmt.Sprintf("zip:%d:%d", parts, parts), events.Index(parts).String())
+
+	// Test cancel writing the workbook by the signal, and the workbook is
+	// still usable after that
+	progress = newProgressRecorder("zip:3")
+	ret = f.(js.Value).Call("WriteToBuffer", js.ValueOf(map[string]interface{}{
+		"OnProgress": progress.Get("onProgress"), "Signal": progress.Get("controller").Get("signal"),
+	}))
+	assert.EqualError(t, errCancelled, ret.Get("error").String())
+	assert.Equal(t, "ERR_CANCELLED", ret.Get("errorInfo").Get("code").String())
+	ret = f.(js.Value).Call("WriteToBuffer")
+	assert.True(t, ret.Get("error").IsNull())
+	assert.True(t, OpenReader(js.Value{}, []js.Value{ret.Get("buffer")}).(js.Value).Get("error").IsNull())
+
+	ret = f.(js.Value).Call("WriteToBuffer", js.ValueOf(map[string]interface{}{"Signal": js.ValueOf("signal")}))
+	assert.EqualError(t, errArgType, ret.Get("error").String())
 }
 
 func TestJsValueToGo(t *testing.T) {
@@ -3018,28 +5349,204 @@ func TestJsValueToGo(t *testing.T) {
 	_, err := jsValueToGo(js.ValueOf(map[string]interface{}{
 		"F1": []interface{}{map[string]interface{}{}},
 		"F2": []interface{}{"f2"},
-	}), reflect.TypeOf(T2{}))
+	}), reflect.TypeOf(T2{}), false)
 	assert.NoError(t, err)
 	_, err = jsValueToGo(js.ValueOf(map[string]interface{}{
 		"F1": true,
 		"F2": []interface{}{"f2"},
-	}), reflect.TypeOf(T2{}))
+	}), reflect.TypeOf(T2{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 	_, err = jsValueToGo(js.ValueOf(map[string]interface{}{
 		"F1": []interface{}{map[string]interface{}{}},
 		"F2": true,
-	}), reflect.TypeOf(T2{}))
-	assert.EqualError(t, err, errArgType.Error())
-	_, err = jsValueToGo(js.ValueOf(map[string]interface{}{
-		"F1": []interface{}{0},
-	}), reflect.TypeOf(T3{}))
+	}), reflect.TypeOf(T2{}), false)
 	assert.EqualError(t, err, errArgType.Error())
+	v, err := jsValueToGo(js.ValueOf(map[string]interface{}{
+		"F1": []interface{}{1},
+	}), reflect.TypeOf(T3{}), false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), *v.Elem().Interface().(T3).F1[0])
 	_, err = jsValueToGo(js.ValueOf(map[string]interface{}{
 		"F1": []interface{}{map[string]interface{}{
-			"F1": []interface{}{0},
+			"F1": []interface{}{"0"},
 		}},
-	}), reflect.TypeOf(T4{}))
+	}), reflect.TypeOf(T4{}), false)
+	assert.EqualError(t, err, errArgType.Error())
+	assert.Equal(t, "F1[0].F1[0]", err.(*argError).path)
+
+	type T5 struct {
+		F1  int8
+		F2  int16
+		F3  int32
+		F4  uint16
+		F5  uint32
+		F6  uintptr
+		F7  float32
+		F8  map[string]int
+		F9  map[string]*T3
+		F10 interface{}
+		F11 [][]string
+		F12 []interface{}
+		F13 [2]int
+		F14 time.Time
+		F15 *int32
+		F16 []byte
+	}
+	num := int32(5)
+	expected := T5{
+		F1: -1, F2: -2, F3: -3, F4: 4, F5: 5, F6: 6, F7: 1.5,
+		F8:  map[string]int{"a": 1},
+		F9:  map[string]*T3{"b": {F1: []*uint32{new(uint32)}}},
+		F10: map[string]interface{}{"c": []interface{}{1.0, "d", true, nil}},
+		F11: [][]string{{"e", "f"}, {}},
+		F12: []interface{}{"g", []byte{1}, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		F13: [2]int{1, 2},
+		F14: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		F15: &num,
+		F16: []byte{1, 2},
+	}
+	result, err := goValueToJS(reflect.ValueOf(expected), reflect.TypeOf(T5{}), false)
+	assert.NoError(t, err)
+	v, err = jsValueToGo(js.ValueOf(result), reflect.TypeOf(T5{}), false)
+	assert.NoError(t, err)
+	expected.F11 = [][]string{{"e", "f"}, nil}
+	assert.Equal(t, expected, v.Elem().Interface())
+
+	for _, value := range []map[string]interface{}{
+		{"F8": []interface{}{map[string]interface{}{"a": "1"}}},
+		{"F8": 1},
+		{"F9": map[string]interface{}{"b": true}},
+		{"F10": js.FuncOf(nil)},
+		{"F10": []interface{}{js.FuncOf(nil)}},
+		{"F10": map[string]interface{}{"a": js.FuncOf(nil)}},
+		{"F11": []interface{}{"e"}},
+		{"F13": []interface{}{1, 2, 3}},
+		{"F14": "2024-01-02"},
+	} {
+		_, err = jsValueToGo(js.ValueOf(value), reflect.TypeOf(T5{}), false)
+		assert.EqualError(t, err, errArgType.Error())
+	}
+
+	bigInt := js.Global().Get("BigInt")
+	v, err = jsValueToGo(js.ValueOf(map[string]interface{}{
+		"UnzipSizeLimit": bigInt.Invoke("9007199254740993"),
+	}), reflect.TypeOf(excelize.Options{}), false)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), v.Elem().Interface().(excelize.Options).UnzipSizeLimit)
+	v, err = jsValueToGo(js.ValueOf(map[string]interface{}{
+		"F10": bigInt.Invoke("18446744073709551615"),
+		"F12": []interface{}{bigInt.Invoke(-1)},
+	}), reflect.TypeOf(T5{}), false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(18446744073709551615), v.Elem().Interface().(T5).F10)
+	assert.Equal(t, []interface{}{int64(-1)}, v.Elem().Interface().(T5).F12)
+	type T8 struct {
+		F1 uint64
+		F2 int
+	}
+	v, err = jsValueToGo(js.ValueOf(map[string]interface{}{
+		"F1": bigInt.Invoke("18446744073709551615"), "F2": bigInt.Invoke(2),
+	}), reflect.TypeOf(T8{}), false)
+	assert.NoError(t, err)
+	assert.Equal(t, T8{F1: 18446744073709551615, F2: 2}, v.Elem().Interface())
+	for _, value := range []map[string]interface{}{
+		{"F1": bigInt.Invoke(-1)},
+		{"F2": bigInt.Invoke("9223372036854775808")},
+	} {
+		_, err = jsValueToGo(js.ValueOf(value), reflect.TypeOf(T8{}), false)
+		assert.EqualError(t, err, errArgType.Error())
+	}
+	_, err = jsValueToGo(js.ValueOf(map[string]interface{}{"F1": bigInt.Invoke(1)}), reflect.TypeOf(T5{}), false)
+	assert.EqualError(t, err, errArgType.Error())
+	_, err = jsValueToGo(js.ValueOf(map[string]interface{}{"F10": bigInt.Invoke("18446744073709551616")}), reflect.TypeOf(T5{}), false)
 	assert.EqualError(t, err, errArgType.Error())
+
+	type T6 struct{ F1 map[int]string }
+	_, err = jsValueToGo(js.ValueOf(map[string]interface{}{"F1": map[string]interface{}{}}), reflect.TypeOf(T6{}), false)
+	assert.EqualError(t, err, errArgType.Error())
+	_, err = goValueToJS(reflect.ValueOf(T6{F1: map[int]string{1: "a"}}), reflect.TypeOf(T6{}), false)
+	assert.EqualError(t, err, errArgType.Error())
+
+	type T7 struct{ F1 fmt.Stringer }
+	_, err = jsValueToGo(js.ValueOf(map[string]interface{}{"F1": "a"}), reflect.TypeOf(T7{}), false)
+	assert.EqualError(t, err, errArgType.Error())
+}
+
+func TestWrapperStructs(t *testing.T) {
+	// Collect the structures used by the registered wrappers
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", nil, 0)
+	assert.NoError(t, err)
+	names := map[string]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		if fn, ok := call.Fun.(*ast.SelectorExpr); !ok || fn.Sel.Name != "TypeOf" {
+			return true
+		}
+		if lit, ok := call.Args[0].(*ast.CompositeLit); ok {
+			if sel, ok := lit.Type.(*ast.SelectorExpr); ok {
+				if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "excelize" {
+					names[sel.Sel.Name] = true
+				}
+			}
+		}
+		return true
+	})
+	types := map[string]reflect.Type{}
+	for _, typ := range []interface{}{
+		excelize.AppProperties{}, excelize.AutoFilterOptions{}, excelize.CalcPropsOptions{},
+		excelize.Cell{}, excelize.Chart{}, excelize.Comment{}, excelize.ConditionalFormatOptions{},
+		excelize.CustomProperty{}, excelize.DataValidation{}, excelize.DefinedName{},
+		excelize.DocProperties{}, excelize.FormControl{}, excelize.FormulaOpts{},
+		excelize.HeaderFooterImageOptions{}, excelize.HeaderFooterOptions{}, excelize.HyperlinkOpts{},
+		excelize.Options{}, excelize.PageLayoutMarginsOptions{}, excelize.PageLayoutOptions{},
+		excelize.Panes{}, excelize.Picture{}, excelize.PivotTableOptions{}, excelize.RichTextRun{},
+		excelize.RowOpts{}, excelize.Shape{}, excelize.SheetPropsOptions{},
+		excelize.SheetProtectionOptions{}, excelize.SlicerOptions{}, excelize.SparklineOptions{},
+		excelize.Style{}, excelize.Table{}, excelize.ViewOptions{}, excelize.WorkbookPropsOptions{},
+		excelize.WorkbookProtectionOptions{},
+	} {
+		types[reflect.TypeOf(typ).Name()] = reflect.TypeOf(typ)
+	}
+	assert.NotEmpty(t, names)
+	for name := range names {
+		assert.Contains(t, types, name, "missing structure %s in the test", name)
+	}
+
+	// Walk every structure reachable from the wrappers, and convert the
+	// structure with sample values between Go and JavaScript
+	reachable := map[reflect.Type]bool{}
+	var walk func(typ reflect.Type)
+	walk = func(typ reflect.Type) {
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			walk(typ.Elem())
+		case reflect.Struct:
+			if reachable[typ] {
+				return
+			}
+			reachable[typ] = true
+			for i := 0; i < typ.NumField(); i++ {
+				if typ.Field(i).IsExported() {
+					walk(typ.Field(i).Type)
+				}
+			}
+		}
+	}
+	for _, typ := range types {
+		walk(typ)
+	}
+	for typ := range reachable {
+		expected := reflect.New(typ).Elem()
+		fillSampleValue(expected, 0)
+		result, err := goValueToJS(expected, typ, false)
+		assert.NoError(t, err, typ.String())
+		v, err := jsValueToGo(js.ValueOf(result), typ, false)
+		assert.NoError(t, err, typ.String())
+		assert.Equal(t, expected.Interface(), v.Elem().Interface(), typ.String())
+	}
 }
 
 func TestJsToGoBaseType(t *testing.T) {
@@ -3057,7 +5564,7 @@ func TestGoValueToJS(t *testing.T) {
 	enable, exp := true, "exp"
 	result, err := goValueToJS(reflect.ValueOf(excelize.Chart{
 		Format: excelize.GraphicOptions{PrintObject: &enable},
-	}), reflect.TypeOf(excelize.Chart{}))
+	}), reflect.TypeOf(excelize.Chart{}), false)
 	assert.NoError(t, err)
 	assert.True(t, js.ValueOf(result).Get("Format").Get("PrintObject").Bool())
 
@@ -3071,7 +5578,7 @@ func TestGoValueToJS(t *testing.T) {
 		F1: []*excelize.DataValidation{{AllowBlank: true}, {}},
 		F2: []*int64{&num},
 		F3: []uint{1},
-	}), reflect.TypeOf(T1{}))
+	}), reflect.TypeOf(T1{}), false)
 	assert.NoError(t, err)
 	assert.True(t, js.ValueOf(result).Get("F1").Index(0).Get("AllowBlank").Bool())
 	assert.Equal(t, 1, js.ValueOf(result).Get("F2").Index(0).Int())
@@ -3082,7 +5589,7 @@ func TestGoValueToJS(t *testing.T) {
 		CustomNumFmt: &exp,
 		Alignment:    &excelize.Alignment{Indent: 1},
 		Border:       []excelize.Border{{Type: "left"}, {Type: "top"}},
-	}), reflect.TypeOf(excelize.Style{}))
+	}), reflect.TypeOf(excelize.Style{}), false)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, js.ValueOf(result).Get("NumFmt").Int())
 	assert.Equal(t, exp, js.ValueOf(result).Get("CustomNumFmt").String())
@@ -3094,63 +5601,88 @@ func TestGoValueToJS(t *testing.T) {
 	type T3 struct{ F1 bool }
 	_, err = goValueToJS(reflect.ValueOf(T2{
 		F1: "foo",
-	}), reflect.TypeOf(T3{}))
+	}), reflect.TypeOf(T3{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 
 	type T4 struct{ F1 *T2 }
 	type T5 struct{ F1 *T3 }
 	_, err = goValueToJS(reflect.ValueOf(T4{
 		F1: &T2{F1: "foo"},
-	}), reflect.TypeOf(T5{}))
+	}), reflect.TypeOf(T5{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 
 	type T6 struct{ F1 *bool }
 	type T7 struct{ F1 *string }
 	_, err = goValueToJS(reflect.ValueOf(T6{
 		F1: &enable,
-	}), reflect.TypeOf(T7{}))
+	}), reflect.TypeOf(T7{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 
 	type T8 struct{ F1 T6 }
 	type T9 struct{ F1 T7 }
 	_, err = goValueToJS(reflect.ValueOf(T8{
 		F1: T6{F1: &enable},
-	}), reflect.TypeOf(T9{}))
+	}), reflect.TypeOf(T9{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 
 	type T10 struct{ F1 []*T2 }
 	type T11 struct{ F1 []*T3 }
 	_, err = goValueToJS(reflect.ValueOf(T10{
 		F1: []*T2{{F1: "foo"}},
-	}), reflect.TypeOf(T11{}))
+	}), reflect.TypeOf(T11{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 
 	type T12 struct{ F1 []*string }
 	type T13 struct{ F1 []*bool }
 	_, err = goValueToJS(reflect.ValueOf(T12{
 		F1: []*string{&exp},
-	}), reflect.TypeOf(T13{}))
+	}), reflect.TypeOf(T13{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 
 	type T14 struct{ F1 []T2 }
 	type T15 struct{ F1 []T3 }
 	_, err = goValueToJS(reflect.ValueOf(T14{
 		F1: []T2{{F1: "foo"}},
-	}), reflect.TypeOf(T15{}))
+	}), reflect.TypeOf(T15{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 
 	type T16 struct{ F1 []string }
 	type T17 struct{ F1 []bool }
 	_, err = goValueToJS(reflect.ValueOf(T16{
 		F1: []string{exp},
-	}), reflect.TypeOf(T17{}))
+	}), reflect.TypeOf(T17{}), false)
 	assert.EqualError(t, err, errArgType.Error())
 
 	type T18 struct{ F1 uint8 }
 	_, err = goValueToJS(reflect.ValueOf(T16{
 		F1: []string{exp},
-	}), reflect.TypeOf(T18{}))
+	}), reflect.TypeOf(T18{}), false)
 	assert.EqualError(t, err, errArgType.Error())
+
+	// Test convert the 64-bit integers out of the safe integer range to BigInt
+	type T19 struct {
+		F1 int64
+		F2 *uint64
+		F3 []int64
+		F4 interface{}
+		F5 int32
+	}
+	u64 := uint64(math.MaxUint64)
+	value := T19{F1: math.MinInt64, F2: &u64, F3: []int64{maxSafeInteger + 2}, F4: int64(1), F5: 1}
+	result, err = goValueToJS(reflect.ValueOf(value), reflect.TypeOf(T19{}), true)
+	assert.NoError(t, err)
+	for field, expected := range map[string]string{"F1": "-9223372036854775808", "F2": "18446744073709551615", "F4": "1"} {
+		assert.Equal(t, jsTypeBigInt, jsType(js.ValueOf(result).Get(field)), field)
+		assert.Equal(t, expected, js.Global().Get("String").Invoke(js.ValueOf(result).Get(field)).String(), field)
+	}
+	assert.Equal(t, "9007199254740993", js.Global().Get("String").Invoke(js.ValueOf(result).Get("F3").Index(0)).String())
+	assert.Equal(t, js.TypeNumber, js.ValueOf(result).Get("F5").Type())
+	v, err := jsValueToGo(js.ValueOf(result), reflect.TypeOf(T19{}), false)
+	assert.NoError(t, err)
+	assert.Equal(t, value, v.Elem().Interface())
+	result, err = goValueToJS(reflect.ValueOf(value), reflect.TypeOf(T19{}), false)
+	assert.NoError(t, err)
+	assert.Equal(t, js.TypeNumber, js.ValueOf(result).Get("F1").Type())
 }
 
 func TestGoBaseTypeToJS(t *testing.T) {
@@ -3166,3 +5698,122 @@ func TestGoBaseTypeToJS(t *testing.T) {
 		assert.EqualError(t, err, errArgType.Error())
 	}
 }
+
+// assertBigInt asserts that the given JavaScript value is a BigInt equal to
+// the expected integer string.
+func assertBigInt(t *testing.T, expected string, value js.Value) {
+	assert.Equal(t, jsTypeBigInt, jsType(value))
+	assert.Equal(t, expected, js.Global().Get("String").Invoke(value).String())
+}
+
+// fillSampleValue fills the given value with non-zero sample data
+// recursively.
+func fillSampleValue(v reflect.Value, depth int) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.String:
+		v.SetString("text")
+	case reflect.Interface:
+		v.Set(reflect.ValueOf("text"))
+	}
+	if depth > 4 {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		ptr := reflect.New(v.Type().Elem())
+		fillSampleValue(ptr.Elem(), depth+1)
+		if !ptr.Elem().IsZero() {
+			v.Set(ptr)
+		}
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fillSampleValue(v.Field(i), depth+1)
+			}
+		}
+	case reflect.Slice:
+		slice := reflect.MakeSlice(v.Type(), 1, 1)
+		fillSampleValue(slice.Index(0), depth+1)
+		v.Set(slice)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fillSampleValue(v.Index(i), depth+1)
+		}
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		elem := reflect.New(v.Type().Elem()).Elem()
+		fillSampleValue(elem, depth+1)
+		m.SetMapIndex(reflect.ValueOf("key").Convert(v.Type().Key()), elem)
+		v.Set(m)
+	}
+}
+
+// asyncIterator returns the async iterator of the given JavaScript async
+// iterable object.
+func asyncIterator(iterable js.Value) js.Value {
+	jsReflect := js.Global().Get("Reflect")
+	return jsReflect.Call("apply", jsReflect.Call("get", iterable, js.Global().Get("Symbol").Get("asyncIterator")),
+		iterable, js.ValueOf([]interface{}{}))
+}
+
+// newProgressRecorder creates the JavaScript object with the OnProgress
+// callback function which records the progress events in "phase:done:total"
+// format, and aborts the controller on the event starts with the given prefix.
+func newProgressRecorder(abortAt string) js.Value {
+	return js.Global().Get("Function").New("abortAt",
+		"const events = [], controller = new AbortController(); return { events, controller, onProgress: (phase, done, total) => { const event = [phase, done, total].join(':'); events.push(event); if (abortAt && event.startsWith(abortAt)) { controller.abort(); } } };",
+	).Invoke(abortAt)
+}
+
+// newReadableStream returns a JavaScript ReadableStream which enqueues the
+// given chunks.
+func newReadableStream(chunks ...interface{}) js.Value {
+	return js.Global().Get("Function").New("chunks",
+		"return new ReadableStream({ start(c) { for (const chunk of chunks) { c.enqueue(chunk); } c.close(); } })",
+	).Invoke(js.ValueOf(chunks))
+}
+
+// awaitPromise blocks until the given JavaScript promise settled, and returns
+// the fulfilled value or the rejection reason as an error.
+func awaitPromise(promise js.Value) (js.Value, error) {
+	value, reason := make(chan js.Value, 1), make(chan js.Value, 1)
+	onFulfilled := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		value <- args[0]
+		return nil
+	})
+	defer onFulfilled.Release()
+	onRejected := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		reason <- args[0]
+		return nil
+	})
+	defer onRejected.Release()
+	promise.Call("then", onFulfilled, onRejected)
+	select {
+	case v := <-value:
+		return v, nil
+	case r := <-reason:
+		return js.Undefined(), fmt.Errorf("%s", r.Get("message").String())
+	}
+}
+
+func catchError(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = r.(js.Error)
+		}
+	}()
+	fn()
+	return
+}
@@ -48,5 +48,19 @@ export default [
             nodeResolve(),
             terser()
         ]
+    },
+    {
+        // ES6 module for the Web Worker and Node.js worker threads
+        input: 'src/worker.js',
+        output: {
+            file: './dist/worker.js',
+            format: 'esm',
+            generatedCode: 'es2015',
+            sourcemap: false,
+        },
+        plugins: [
+            nodeResolve(),
+            terser()
+        ]
     }
 ];
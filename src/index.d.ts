@@ -131,20 +131,181 @@ declare module 'excelize-wasm' {
    *
    * CultureInfo specifies the country code for applying built-in language
    * number format code these effect by the system's local language settings.
+   *
+   * ThrowOnError specifies if enable the exception mode for the workbook
+   * opened by NewFile and OpenReader. The methods of the workbook will throw
+   * the ExcelizeError instead of returning the result object with the error
+   * field, and return the payload directly in the exception mode, for
+   * example, GetCellValue returns the cell value string instead of
+   * { value, error }. The global error handling mode set by SetThrowOnError
+   * will be used if it not set.
+   *
+   * Strict specifies if enable the strict mode for the workbook opened by
+   * NewFile and OpenReader. The methods of the workbook will reject the
+   * options with unknown fields, unknown enumeration values, fractional
+   * integers and out-of-range numbers with the 'ERR_ARG_FIELD' or
+   * 'ERR_ARG_VALUE' error code instead of ignoring them silently. The global
+   * mode set by SetStrictMode will be used if it not set.
+   *
+   * BigInt specifies if enable the BigInt mode for the workbook opened by
+   * NewFile and OpenReader. The methods of the workbook will return the
+   * 64-bit integer fields of the result objects as bigint instead of the
+   * number which loses precision above 2^53, for example, the ReadingOrder
+   * field of the style alignment. This mode is disabled by default.
    */
   export type Options = {
     MaxCalcIterations?: number;
     Password?:          string;
     RawCellValue?:      boolean;
-    UnzipSizeLimit?:    number;
-    UnzipXMLSizeLimit?: number;
+    UnzipSizeLimit?:    number | bigint;
+    UnzipXMLSizeLimit?: number | bigint;
     TmpDir?:            string;
     ShortDatePattern?:  string;
     LongDatePattern?:   string;
     LongTimePattern?:   string;
     CultureInfo?:       CultureName;
+    ThrowOnError?:      boolean;
+    Strict?:            boolean;
+    BigInt?:            boolean;
+  };
+
+  /**
+   * ProgressOptions define the options for the long-running functions, which
+   * report the progress to the OnProgress callback function with the phase,
+   * the number of the done and total steps, the total will be 0 if it's
+   * unknown. The phases are 'read' and 'unzip' on opening, 'zip' on writing
+   * and 'calc' on calculating. The function call will be stopped with the
+   * 'ERR_CANCELLED' error if the Signal aborted, the signal could be aborted
+   * in the callback function for the synchronous function call. The callback
+   * function and the signal can't be passed to the worker created by the
+   * initWorker.
+   */
+  export type ProgressOptions = Options & {
+    OnProgress?: (phase: string, done: number, total: number) => void;
+    Signal?:     AbortSignal;
+  };
+
+  /**
+   * CharsetTranscoder defines the codepage transcoder for decoding the non
+   * UTF-8 XML parts of the workbook. It could be a function which accepts the
+   * charset label declared by the XML part and the bytes of the part, and
+   * returns the decoded string, or returns undefined to decode by the
+   * built-in decoder of the label. It could also be the name of the built-in
+   * encoding, for example: 'windows-1251', 'x-mac-cyrillic' or 'shift_jis',
+   * which will be used to decode the non UTF-8 parts declared with the labels
+   * not supported by the built-in encodings, the other parts will be decoded
+   * by their labels.
+   */
+  export type CharsetTranscoder = ((charset: string, input: Uint8Array) => string | undefined | null) | string;
+
+  /**
+   * OpenOptions define the options for opening the spreadsheet, the
+   * CharsetReader specifies the transcoder which will be applied before
+   * parsing the workbook, so the parts parsed on opening, such as the
+   * workbook, styles and theme parts, are decoded by it as well, except for
+   * the encrypted workbook.
+   */
+  export type OpenOptions = ProgressOptions & {
+    CharsetReader?: CharsetTranscoder;
+  };
+
+  /**
+   * CSVOptions define the options for the CSV format. The Delimiter specifies
+   * the single character field delimiter, default is ','. The Quote specifies
+   * the quote style of the fields: 'minimal' quotes the fields which contain
+   * the delimiter, quote, line breaks or the leading and trailing spaces,
+   * 'all' quotes all fields, 'nonNumeric' quotes the fields which are not
+   * numbers, and 'none' writes the fields as is. The Encoding specifies the
+   * name of the encoding, for example: 'utf-8', 'utf-16le' or
+   * 'windows-1251', and the BOM specifies whether to write the byte order
+   * mark for the Unicode encodings. The Range restricts the cells by a range
+   * reference, for example: 'A1:D10'. The HiddenRows and HiddenCols specify
+   * whether to 'include' or 'skip' the hidden rows and columns.
+   */
+  export type CSVOptions = {
+    Delimiter?:    string;
+    Quote?:        'minimal' | 'all' | 'nonNumeric' | 'none';
+    LineEnding?:   '\r\n' | '\n';
+    Encoding?:     string;
+    BOM?:          boolean;
+    Range?:        string;
+    RawCellValue?: boolean;
+    HiddenRows?:   'include' | 'skip';
+    HiddenCols?:   'include' | 'skip';
+  };
+
+  /**
+   * CSVImportOptions define the options for importing the CSV data. The
+   * Delimiter specifies the single character field delimiter, it will be
+   * detected from the comma, tab, semicolon and vertical bar if it's empty.
+   * The Encoding specifies the name of the encoding of the CSV bytes, default
+   * is 'utf-8', and the byte order mark will be removed. The InferTypes
+   * specifies whether to write the numbers, booleans, percentages and ISO
+   * 8601 dates, for example: '2024-01-15' or '2024-01-15 10:30:00', with the
+   * proper cell types and number formats.
+   */
+  export type CSVImportOptions = {
+    Delimiter?:  string;
+    Encoding?:   string;
+    InferTypes?: boolean;
+  };
+
+  /**
+   * ErrorInfo directly maps the structured error information of the failed
+   * function call. The code field is a stable error code of the error, for
+   * example: 'ERR_SHEET_NOT_EXIST', 'ERR_ARG_NUM', 'ERR_ARG_TYPE',
+   * 'ERR_ARG_FIELD', 'ERR_ARG_VALUE' or 'ERR_CANCELLED', and it
+   * will be 'ERR_UNKNOWN' if the error doesn't have a stable error code. The
+   * argIndex field specifies the index of the failing argument, and the path
+   * field specifies the path of the failing option field in the argument,
+   * for example: 'Series[2].Marker.Symbol'.
+   */
+  export type ErrorInfo = {
+    code:     string;
+    message:  string;
+    argIndex: number | null;
+    path:     string | null;
+  };
+
+  /**
+   * ExcelizeError is the error thrown by the functions in the exception mode,
+   * which carries the structured error information of the failed function
+   * call.
+   */
+  export class ExcelizeError extends Error {
+    constructor(message?: string, info?: Partial<ErrorInfo>);
+    name:     'ExcelizeError';
+    code:     string;
+    argIndex: number | null;
+    path:     string | null;
+  }
+
+  /**
+   * TypedOptions define the options for getting the typed cell values, the
+   * number, boolean, date and error cells will be returned as number,
+   * boolean, Date and CellError instead of the formatted string if the Typed
+   * field is true. The integer cells out of the safe integer range will be
+   * returned as bigint if the BigInt field is true.
+   */
+  export type TypedOptions = Options & {
+    Typed:   true;
+    BigInt?: boolean;
+  };
+
+  /**
+   * CellError directly maps the error value of the cell, for example:
+   * { Error: '#DIV/0!' }.
+   */
+  export type CellError = {
+    Error: string;
   };
 
+  /**
+   * CellValue defined the typed value of the cell, the empty cell will be
+   * null.
+   */
+  export type CellValue = boolean | number | bigint | string | Date | CellError | null;
+
   /**
    * Border directly maps the border settings of the cells.
    */
@@ -189,7 +350,7 @@ declare module 'excelize-wasm' {
     Horizontal?:      string;
     Indent?:          number;
     JustifyLastLine?: boolean;
-    ReadingOrder?:    number;
+    ReadingOrder?:    number | bigint;
     RelativeIndent?:  number;
     ShrinkToFit?:     boolean;
     TextRotation?:    number;
@@ -279,7 +440,7 @@ declare module 'excelize-wasm' {
    */
   export type CustomProperty = {
     Name:   string;
-    Value?: boolean | number | string | null;
+    Value?: boolean | number | string | Date | null;
   };
 
   /**
@@ -733,6 +894,29 @@ declare module 'excelize-wasm' {
     IgnoredErrorsCalculatedColumn,
   }
 
+  /**
+   * BinaryData defines the binary data which will be read synchronously,
+   * including the Uint8Array, Node.js Buffer, other typed arrays, DataView and
+   * ArrayBuffer.
+   */
+  export type BinaryData = ArrayBuffer | ArrayBufferView;
+
+  /**
+   * BinaryStream defines the binary data which will be read incrementally and
+   * asynchronously, the functions taking them return a promise of the result.
+   */
+  export type BinaryStream = Blob | ReadableStream<Uint8Array>;
+
+  /**
+   * NodeWritable defines the Node.js writable stream, such as the
+   * fs.WriteStream, which could be used as the sink of the WriteTo.
+   */
+  export type NodeWritable = {
+    write(chunk: Uint8Array): boolean;
+    end(): unknown;
+    once(event: string, listener: (...args: any[]) => void): unknown;
+  };
+
   /**
    * HeaderFooterImageOptions defines the settings for an image to be accessible
    * from the worksheet header and footer options.
@@ -1022,13 +1206,13 @@ declare module 'excelize-wasm' {
   export type DocProperties = {
     Category?:       string;
     ContentStatus?:  string;
-    Created?:        string;
+    Created?:        string | Date;
     Creator?:        string;
     Description?:    string;
     Identifier?:     string;
     Keywords?:       string;
     LastModifiedBy?: string;
-    Modified?:       string;
+    Modified?:       string | Date;
     Revision?:       string;
     Subject?:        string;
     Title?:          string;
@@ -1072,6 +1256,215 @@ declare module 'excelize-wasm' {
     GetEndAxis: () => string;
   }
 
+  /**
+   * RowOpts define the options for the set row, it can be used for the
+   * stream writer's SetRow function.
+   */
+  export type RowOpts = {
+    Height?:       number;
+    Hidden?:       boolean;
+    StyleID?:      number;
+    OutlineLevel?: number;
+  };
+
+  /**
+   * Cell can be used directly in the stream writer's SetRow function to
+   * specify a style, formula and a value.
+   */
+  export type Cell = {
+    StyleID?: number;
+    Formula?: string;
+    Value?:   boolean | number | bigint | string | Date | null;
+  };
+
+  /**
+   * RangeValuesOptions directly maps the options for the GetRangeValues
+   * function. As specifies the type of the returned values, currently only
+   * 'float64' supported. ByColumn specifies if return the values and
+   * validity bitmaps of each column.
+   */
+  export type RangeValuesOptions = {
+    As?:       'float64';
+    ByColumn?: boolean;
+  };
+
+  /**
+   * RecordsOptions directly maps the options for the GetRecords function.
+   * HeaderRow specifies the row number of the header row, default is the
+   * first row of the range. Range restricts the cells by a range reference,
+   * default is the used range of the worksheet. SkipEmpty specifies whether
+   * to skip the rows in which all cells are empty. The typed cell values
+   * will be returned if the Typed field is true.
+   */
+  export type RecordsOptions = {
+    HeaderRow?:    number;
+    Range?:        string;
+    SkipEmpty?:    boolean;
+    Typed?:        boolean;
+    BigInt?:       boolean;
+    RawCellValue?: boolean;
+  };
+
+  /**
+   * HTMLOptions directly maps the options for the RenderHTML function.
+   * ShowHidden specifies whether to render the hidden rows and columns.
+   */
+  export type HTMLOptions = {
+    ShowHidden?: boolean;
+  };
+
+  /**
+   * SetRecordsOptions directly maps the options for the SetRecords function.
+   * Columns specifies the keys of the records to be written in order, default
+   * is the keys of the records in the order of their first appearance.
+   * HeaderStyle specifies the style ID of the header cells. AsTable specifies
+   * the table options for wrapping the written cells as a table, the range of
+   * the table will be set automatically. NumberFormats specifies the built-in
+   * number format ID or the custom number format code of the columns, for
+   * example: { Amount: '#,##0.00', Date: 14 }.
+   */
+  export type SetRecordsOptions = {
+    Columns?:       string[];
+    HeaderStyle?:   number;
+    AsTable?:       Omit<TableOptions, 'Range'>;
+    NumberFormats?: { [column: string]: number | string };
+  };
+
+  /**
+   * CellEntry directly maps the entry of the SetCells function, which could be
+   * a cell value or a record with value, formula and style ID.
+   */
+  export type CellEntry = boolean | number | bigint | string | Date | null | Cell;
+
+  /**
+   * CellEntryError directly maps the error of the failing entry of the
+   * SetCells function.
+   */
+  export type CellEntryError = {
+    cell:      string;
+    error:     string;
+    errorInfo: ErrorInfo;
+  };
+
+  /**
+   * Cols defines an iterator to a sheet, it can also be iterated by the
+   * "for await...of" statement, which yields the rows of each column. The
+   * iterator will be released after the last column, then the Next and Error
+   * functions keep returning the final results, and the other functions
+   * return the 'ERR_OBJECT_CLOSED' error.
+   */
+  export interface Cols extends AsyncIterable<string[]> {
+    /**
+     * Error will return an error when the error occurs.
+     */
+    Error: () => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * Next will return true if the next column is found.
+     */
+    Next: () => boolean;
+    /**
+     * Rows return the current column's row values. If the options are not
+     * given, the options specified when creating the columns iterator will
+     * be used.
+     * @param opts The options for get rows
+     */
+    Rows: (opts?: Options) => { result: string[], error: string | null, errorInfo?: ErrorInfo };
+  }
+
+  /**
+   * Rows defines an iterator to a sheet, it can also be iterated by the
+   * "for await...of" statement, which yields the columns of each row. The
+   * iterator will be closed and released after the last row or closed, then
+   * the Next, Error and Close functions keep returning the final results, and
+   * the other functions return the 'ERR_OBJECT_CLOSED' error.
+   */
+  export interface Rows extends AsyncIterable<string[]> {
+    /**
+     * Close closes the open worksheet XML file in the system temporary
+     * directory.
+     */
+    Close: () => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * Columns return the current row's column values. This fetches the
+     * worksheet data as a stream, returns each cell in a row as is, and will
+     * not skip empty rows in the tail of the worksheet.
+     * @param opts The options for get columns
+     */
+    Columns: (opts?: Options) => { result: string[], error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * Error will return the error when the error occurs.
+     */
+    Error: () => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * GetRowOpts will return the RowOpts of the current row.
+     */
+    GetRowOpts: () => { opts: RowOpts, error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * Next will return true if find the next row element.
+     */
+    Next: () => boolean;
+  }
+
+  /**
+   * StreamWriter defined the type of stream writer. The stream writer will be
+   * released after flushed, then its functions return the
+   * 'ERR_OBJECT_CLOSED' error.
+   */
+  export interface StreamWriter {
+    /**
+     * AddTable creates an Excel table for the stream writer using the given
+     * cell range and format set.
+     * @param table The table options
+     */
+    AddTable: (table: TableOptions) => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * Flush ending the streaming writing process.
+     */
+    Flush: () => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * InsertPageBreak creates a page break to determine where the printed
+     * page ends and where begins the next one by a given cell reference, the
+     * content before the page break will be printed on one page and after
+     * the page break on another.
+     * @param cell The cell reference
+     */
+    InsertPageBreak: (cell: string) => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * MergeCell provides a function to merge cells by a given range reference
+     * for the stream writer. Don't create a merged cell that overlaps with
+     * another existing merged cell.
+     * @param topLeftCell The top-left cell reference
+     * @param bottomRightCell The right-bottom cell reference
+     */
+    MergeCell: (topLeftCell: string, bottomRightCell: string) => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * SetColWidth provides a function to set the width of a single column or
+     * multiple columns for the stream writer. Note that you must call the
+     * SetColWidth function before the SetRow function.
+     * @param minVal The start column number
+     * @param maxVal The end column number
+     * @param width The column width
+     */
+    SetColWidth: (minVal: number, maxVal: number, width: number) => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * SetPanes provides a function to create and remove freeze panes and
+     * split panes by giving panes options for the stream writer. Note that
+     * you must call the SetPanes function before the SetRow function.
+     * @param panes The panes options
+     */
+    SetPanes: (panes: Panes) => { error: string | null, errorInfo?: ErrorInfo };
+    /**
+     * SetRow writes an array to stream rows by giving starting cell reference
+     * and a pointer to an array of values. Note that you must call the Flush
+     * function to end the streaming writing process, and ensure that the row
+     * numbers are in ascending order when set rows.
+     * @param cell The start cell reference
+     * @param values The row values
+     * @param opts The row options
+     */
+    SetRow: (cell: string, values: (boolean | number | bigint | string | Date | Cell | null)[], opts?: RowOpts) => { error: string | null, errorInfo?: ErrorInfo };
+  }
+
   /**
    * PageLayoutOptions directly maps the settings of page layout.
    */
@@ -1254,7 +1647,7 @@ declare module 'excelize-wasm' {
    * coordinates or returns an error.
    * @param cell The cell reference
    */
-  export function CellNameToCoordinates(cell: string): { col: number, row: number, error: string | null }
+  export function CellNameToCoordinates(cell: string): { col: number, row: number, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * ColumnNameToNumber provides a function to convert Excel sheet column name
@@ -1262,14 +1655,14 @@ declare module 'excelize-wasm' {
    * incorrect.
    * @param name The column name
    */
-  export function ColumnNameToNumber(name: string): { col: number, error: string | null }
+  export function ColumnNameToNumber(name: string): { col: number, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * ColumnNumberToName provides a function to convert the integer to Excel
    * sheet column title.
    * @param num The column name
    */
-  export function ColumnNumberToName(num: number): { col: string, error: string | null }
+  export function ColumnNumberToName(num: number): { col: string, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * CoordinatesToCellName converts [X, Y] coordinates to alpha-numeric cell
@@ -1278,7 +1671,7 @@ declare module 'excelize-wasm' {
    * @param row The row number
    * @param abs Specifies the absolute cell references
    */
-  export function CoordinatesToCellName(col: number, row: number, abs?: boolean): { cell: string, error: string | null }
+  export function CoordinatesToCellName(col: number, row: number, abs?: boolean): { cell: string, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * HSLToRGB converts an HSL triple to a RGB triple.
@@ -1286,14 +1679,14 @@ declare module 'excelize-wasm' {
    * @param s Saturation
    * @param l Lightness
    */
-  export function HSLToRGB(h: number, s: number, l: number): { r: number, g: number, b: number, error: string | null }
+  export function HSLToRGB(h: number, s: number, l: number): { r: number, g: number, b: number, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * JoinCellName joins cell name from column name and row number.
    * @param col The column name
    * @param row The row number
    */
-  export function JoinCellName(col: string, row: number): { cell: string, error: string | null }
+  export function JoinCellName(col: string, row: number): { cell: string, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * RGBToHSL converts an RGB triple to a HSL triple.
@@ -1301,20 +1694,20 @@ declare module 'excelize-wasm' {
    * @param g Green
    * @param b Blue
    */
-  export function RGBToHSL(r: number, g: number, b: number): { h: number, s: number, l: number, error: string | null }
+  export function RGBToHSL(r: number, g: number, b: number): { h: number, s: number, l: number, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * SplitCellName splits cell name to column name and row number.
    * @param cell The cell reference
    */
-  export function SplitCellName(cell: string): { col: string, row: number, error: string | null }
+  export function SplitCellName(cell: string): { col: string, row: number, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * ThemeColor applied the color with tint value.
    * @param baseColor Base color in hex format
    * @param tint A mixture of a color with white
    */
-  export function ThemeColor(baseColor: string, tint: number): { color: string, error: string | null }
+  export function ThemeColor(baseColor: string, tint: number): { color: string, error: string | null, errorInfo?: ErrorInfo }
 
   /**
    * NewFile provides a function to create new file by default template.
@@ -1324,11 +1717,64 @@ declare module 'excelize-wasm' {
 
   /**
    * OpenReader read data stream from buffer and return a populated spreadsheet
-   * file.
+   * file. The Blob and ReadableStream, for example, the file of the browser
+   * file input or the body of the fetch response, will be read incrementally,
+   * and a promise of the spreadsheet file will be returned for them. No
+   * workbook will be returned if the signal aborted. The CharsetReader
+   * option will be applied before parsing the workbook, except for the
+   * encrypted workbook.
    * @param r The contents buffer of the file
    * @param opts The options for open and reading spreadsheet
    */
-  export function OpenReader(r: Uint8Array, opts?: Options): NewFile;
+  export function OpenReader(r: BinaryStream, opts?: OpenOptions): Promise<NewFile>;
+  export function OpenReader(r: BinaryData, opts?: OpenOptions): NewFile;
+
+  /**
+   * SetThrowOnError provides a function to set the global error handling
+   * mode. The functions will throw the ExcelizeError instead of returning the
+   * result object with the error field, and return the payload of the result
+   * object directly in the exception mode. The result without payload will be
+   * returned as undefined, the result with only one field will be returned as
+   * the value of the field, and the others will be returned as the result
+   * object without the error field. This mode will be applied on the exported
+   * functions and the workbooks opened later without the ThrowOnError
+   * option. For example:
+   *
+   * ```typescript
+   * excelize.SetThrowOnError(true);
+   * try {
+   *   const f = excelize.NewFile();
+   *   f.SetCellValue('Sheet1', 'A1', 'Hello');
+   *   const value = f.GetCellValue('Sheet1', 'A1');
+   *   console.log(value);
+   * } catch (err) {
+   *   if (err instanceof excelize.ExcelizeError) {
+   *     console.log(err.code, err.message);
+   *   }
+   * }
+   * ```
+   *
+   * Note that the exception mode requires the Function constructor, which is
+   * blocked by the Content Security Policy without 'unsafe-eval'.
+   * @param enable Specifies if enable the exception mode
+   */
+  export function SetThrowOnError(enable: boolean): { error: string | null, errorInfo?: ErrorInfo }
+
+  /**
+   * SetStrictMode provides a function to set the global option validation
+   * mode. The functions will reject the options with unknown fields, for
+   * example, the misspelled 'Bordr' of the style, the unknown enumeration
+   * values, the fractional numbers for integer fields and the out-of-range
+   * numbers in the strict mode, instead of ignoring them silently. The
+   * errorInfo of the result will specify the path of the failing option
+   * field. This mode will be applied on the exported functions and the
+   * workbooks opened later without the Strict option.
+   * @param enable Specifies if enable the strict mode
+   */
+  export function SetStrictMode(enable: boolean): { error: string | null, errorInfo?: ErrorInfo }
+
+  // Code generated by bindgen. DO NOT EDIT.
+  // End of code generated by bindgen.
 
   /**
    * @constructor
@@ -1941,7 +2387,7 @@ declare module 'excelize-wasm' {
      * @param combo Specifies the create a chart that combines two or more
      *  chart types in a single chart
      */
-    AddChart(sheet: string, cell: string, chart: Chart, combo?: Chart): { error: string | null }
+    AddChart(sheet: string, cell: string, chart: Chart, combo?: Chart): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddChartSheet provides the method to create a chartsheet by given chart
@@ -1953,7 +2399,7 @@ declare module 'excelize-wasm' {
      * @param combo Specifies the create a chart that combines two or more
      *  chart types in a single chart
      */
-    AddChartSheet(sheet: string, chart: Chart, combo?: Chart): { error: string | null }
+    AddChartSheet(sheet: string, chart: Chart, combo?: Chart): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddComment provides the method to add comments in a sheet by giving the
@@ -1985,7 +2431,7 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param comment The comment options
      */
-    AddComment(sheet: string, comment: Comment): { error: string | null }
+    AddComment(sheet: string, comment: Comment): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddDataValidation provides set data validation on a range of the worksheet
@@ -1993,7 +2439,7 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param dv The data validation rules
      */
-    AddDataValidation(sheet: string, dv: DataValidation): { error: string | null }
+    AddDataValidation(sheet: string, dv: DataValidation): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddDataValidation provides the method to ignored error for a range of
@@ -2002,7 +2448,7 @@ declare module 'excelize-wasm' {
      * @param rangeRef The top-left and right-bottom cell range reference
      * @param ignoredErrorsType The enumeration value of ignored errors type
      */
-    AddIgnoredErrors(sheet: string, rangeRef: string, ignoredErrorsType: IgnoredErrorsType): { error: string | null }
+    AddIgnoredErrors(sheet: string, rangeRef: string, ignoredErrorsType: IgnoredErrorsType): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddPictureFromBytes provides the method to add picture in a sheet by given
@@ -2058,11 +2504,15 @@ declare module 'excelize-wasm' {
      * cells), "twoCell" (Move and size with cells), and "absolute" (Don't move
      * or size with cells). If you don't set this parameter, the default
      * positioning is to move and size with cells.
+     *
+     * The File of the picture could be the Blob or ReadableStream, which will
+     * be read incrementally, and a promise of the result will be returned.
      * @param sheet The worksheet name
      * @param cell The cell reference
      * @param pic The picture format options
      */
-    AddPictureFromBytes(sheet: string, cell: string, pic: Picture): { error: string | null }
+    AddPictureFromBytes(sheet: string, cell: string, pic: Omit<Picture, 'File'> & { File: BinaryStream }): Promise<{ error: string | null, errorInfo?: ErrorInfo }>
+    AddPictureFromBytes(sheet: string, cell: string, pic: Omit<Picture, 'File'> & { File: BinaryData }): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddFormControl provides the method to add form control object in a
@@ -2159,7 +2609,7 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param opts The form control options
      */
-    AddFormControl(sheet: string, opts: FormControl): { error: string | null }
+    AddFormControl(sheet: string, opts: FormControl): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddHeaderFooterImage provides a mechanism to set the graphics that can be
@@ -2169,10 +2619,14 @@ declare module 'excelize-wasm' {
      * The extension should be provided with a "." in front, e.g. ".png".
      * The width and height should have units in them, e.g. "100pt".
      *
+     * The File of the image could be the Blob or ReadableStream, which will be
+     * read incrementally, and a promise of the result will be returned.
+     *
      * @param sheet The worksheet name
      * @param opts The header footer image options
      */
-    AddHeaderFooterImage(sheet: string, opts: HeaderFooterImageOptions): { error: string | null }
+    AddHeaderFooterImage(sheet: string, opts: Omit<HeaderFooterImageOptions, 'File'> & { File: BinaryStream }): Promise<{ error: string | null, errorInfo?: ErrorInfo }>
+    AddHeaderFooterImage(sheet: string, opts: Omit<HeaderFooterImageOptions, 'File'> & { File: BinaryData }): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddPivotTable provides the method to add pivot table by given pivot
@@ -2240,16 +2694,7 @@ declare module 'excelize-wasm' {
      *
      * @param opt The pivot table option
      */
-    AddPivotTable(opt: PivotTableOptions): { error: string | null }
-
-    /**
-     * AddShape provides the method to add shape in a sheet by given worksheet
-     * index, shape format set (such as offset, scale, aspect ratio setting
-     * and print settings) and properties set.
-     * @param sheet The worksheet name
-     * @param opts The shape options
-     */
-    AddShape(sheet: string, opts: Shape): { error: string | null }
+    AddPivotTable(opt: PivotTableOptions): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddSlicer function inserts a slicer by giving the worksheet name and
@@ -2257,63 +2702,17 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param opts The slicer options
      */
-    AddSlicer(sheet: string, opts: SlicerOptions): { error: string | null }
-
-    /**
-     * AddSparkline provides a function to add sparklines to the worksheet by
-     * given formatting options. Sparklines are small charts that fit in a single
-     * cell and are used to show trends in data. Sparklines are a feature of Excel
-     * 2010 and later only. You can write them to an XLSX file that can be read by
-     * Excel 2007, but they won't be displayed. For example, add a grouped
-     * sparkline. Changes are applied to all three:
-     *
-     * ```typescript
-     * const { error } = f.AddSparkline('Sheet1', {
-     *   Location: ['A1', 'A2', 'A3'],
-     *   Range:    ['Sheet2!A1:J1', 'Sheet2!A2:J2', 'Sheet2!A3:J3'],
-     *   Markers:  true,
-     * });
-     * ```
-     *
-     * The following shows the formatting options of sparkline supported by excelize-wasm:
-     *
-     *      Parameter   | Description
-     *     -------------+--------------------------------------------
-     *      Location    | Required, must have the same number with 'Range' parameter
-     *      Range       | Required, must have the same number with 'Location' parameter
-     *      Type        | Enumeration value: line, column, win_loss
-     *      Style       | Value range: 0 - 35
-     *      Hight       | Toggle sparkline high points
-     *      Low         | Toggle sparkline low points
-     *      First       | Toggle sparkline first points
-     *      Last        | Toggle sparkline last points
-     *      Negative    | Toggle sparkline negative points
-     *      Markers     | Toggle sparkline markers
-     *      Axis        | Used to specify if show horizontal axis
-     *      Reverse     | Used to specify if enable plot data right-to-left
-     *      SeriesColor | An RGB Color is specified as RRGGBB
-     *
-     * @param sheet The worksheet name
-     * @param opts The sparkline options
-     */
-    AddSparkline(sheet: string, opts: SparklineOptions): { error: string | null }
-
-    /**
-     * AddTable provides the method to add table in a worksheet by given
-     * worksheet name, range reference and format set.
-     * @param sheet The worksheet name
-     * @param rangeRef The top-left and right-bottom cell range reference
-     * @param opts The table options
-     */
-    AddTable(sheet: string, opts: TableOptions): { error: string | null }
+    AddSlicer(sheet: string, opts: SlicerOptions): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AddVBAProject provides the method to add vbaProject.bin file which
      * contains functions and/or macros. The file extension should be XLSM or
-     * XLTM.
+     * XLTM. The Blob and ReadableStream will be read incrementally, and a
+     * promise of the result will be returned for them.
      * @param file The contents buffer of the vbaProject.bin file
      */
-    AddVBAProject(file: Uint8Array): { error: string | null }
+    AddVBAProject(file: BinaryStream): Promise<{ error: string | null, errorInfo?: ErrorInfo }>
+    AddVBAProject(file: BinaryData): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * AutoFilter provides the method to add auto filter in a worksheet by
@@ -2324,13 +2723,15 @@ declare module 'excelize-wasm' {
      * @param rangeRef The top-left and right-bottom cell range reference
      * @param opts The auto filter options
      */
-    AutoFilter(sheet: string, rangeRef: string, opts: AutoFilterOptions[]): { error: string | null }
+    AutoFilter(sheet: string, rangeRef: string, opts: AutoFilterOptions[]): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * CalcCellValue provides a function to get calculated cell value. This
      * feature is currently in working processing. Iterative calculation,
      * implicit intersection, explicit intersection, array formula, table
-     * formula and some other formulas are not supported currently.
+     * formula and some other formulas are not supported currently. The
+     * calculation will not be started if the Signal option aborted, but it
+     * can't be interrupted after started.
      *
      * Supported formula functions:
      *
@@ -2795,32 +3196,74 @@ declare module 'excelize-wasm' {
      * @param cell The cell reference
      * @param opts The options for get calculated cell value
      */
-    CalcCellValue(sheet: string, cell: string, opts?: Options): { value: string, error: string | null }
+    CalcCellValue(sheet: string, cell: string, opts?: ProgressOptions): { value: string, error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * CopySheet provides a function to duplicate a worksheet by gave source
-     * and target worksheet index. Note that currently doesn't support
-     * duplicate workbooks that contain tables, charts or pictures.
-     * @param from Source sheet index
-     * @param to Target sheet index
+     * CharsetTranscoder set user defined codepage transcoder function for
+     * open workbook from non UTF-8 encoding. For example, decode the legacy
+     * encoded parts by the TextDecoder:
+     *
+     * ```typescript
+     * f.CharsetTranscoder((charset, input) => new TextDecoder('windows-1251').decode(input));
+     * ```
+     *
+     * @param transcoder The transcoder function or the encoding name
      */
-    CopySheet(from: number, to: number): { error: string | null }
+    CharsetTranscoder(transcoder: CharsetTranscoder): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * DeleteChart provides a function to delete chart in spreadsheet by given
-     * worksheet name and cell reference.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
+     * Close closes and cleanup the open temporary file for the spreadsheet,
+     * and releases all the functions registered for the workbook and the
+     * objects derived from it, such as merged cells, stream writer and
+     * iterators. After the workbook closed, all the functions of these
+     * objects will return the "file closed" error. For example:
+     *
+     * ```typescript
+     * const f = excelize.NewFile();
+     * // ...
+     * const { error } = f.Close();
+     * if (error) {
+     *   console.log(error);
+     * }
+     * ```
      */
-    DeleteChart(sheet: string, cell: string): { error: string | null }
+    Close(): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * DeleteComment provides the method to delete comment in a sheet by given
-     * worksheet name.
+     * Cols returns a columns iterator, used for streaming reading data for a
+     * worksheet with a large data. This function is concurrency safe. The
+     * optional options will be used as the default options for getting the
+     * rows of each column. For example:
+     *
+     * ```typescript
+     * const cols = f.Cols('Sheet1', { RawCellValue: true });
+     * if (cols.error) {
+     *   console.log(cols.error);
+     *   return;
+     * }
+     * while (cols.Next()) {
+     *   const { result, error } = cols.Rows();
+     *   if (error) {
+     *     console.log(error);
+     *     break;
+     *   }
+     *   console.log(result);
+     * }
+     * ```
+     *
+     * The returned iterator can also be iterated by the "for await...of"
+     * statement:
+     *
+     * ```typescript
+     * for await (const col of f.Cols('Sheet1')) {
+     *   console.log(col);
+     * }
+     * ```
+     *
      * @param sheet The worksheet name
-     * @param cell The cell reference
+     * @param opts The options for get rows of each column
      */
-    DeleteComment(sheet: string, cell: string): { error: string | null }
+    Cols(sheet: string, opts?: Options): Cols & { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * DeleteDataValidation delete data validation by given worksheet name and
@@ -2829,112 +3272,53 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param sqref The cell reference sequence
      */
-    DeleteDataValidation(sheet: string, sqref?: string): { error: string | null }
-
-    /**
-     * DeleteDefinedName provides a function to delete the defined names of the
-     * workbook or worksheet. If not specified scope, the default scope is
-     * workbook.
-     * @param definedName The name for a cell or cell range on a worksheet
-     */
-    DeleteDefinedName(definedName: DefinedName): { error: string | null }
-
-    /**
-     * DeleteFormControl provides the method to delete form control in a
-     * worksheet by given worksheet name and cell reference.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     */
-    DeleteFormControl(sheet: string, cell: string): { error: string | null }
-
-    /**
-     * DeletePicture provides a function to delete charts in spreadsheet by
-     * given worksheet name and cell reference. Note that the image file won't
-     * be deleted from the document currently.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     */
-    DeletePicture(sheet: string, cell: string): { error: string | null }
-
-    /**
-     * DeleteSheet provides a function to delete worksheet in a workbook by
-     * given worksheet name. Use this method with caution, which will affect
-     * changes in references such as formulas, charts, and so on. If there is
-     * any referenced value of the deleted worksheet, it will cause a file
-     * error when you open it. This function will be invalid when only one
-     * worksheet is left.
-     * @param sheet The worksheet name
-     */
-    DeleteSheet(sheet: string): { error: string | null }
-
-    /**
-     * DeleteSlicer provides the method to delete a slicer by a given slicer
-     * name.
-     * @param name The slicer name
-     */
-    DeleteSlicer(name: string): { error: string | null }
+    DeleteDataValidation(sheet: string, sqref?: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * DeleteTable provides the method to delete table by given table name.
-     * @param name The table name
-     */
-    DeleteTable(name: string): { error: string | null }
-
-    /**
-     * DuplicateRow inserts a copy of specified row (by its Excel row number)
-     * below. Use this method with caution, which will affect changes in
-     * references such as formulas, charts, and so on. If there is any
-     * referenced value of the worksheet, it will cause a file error when you
-     * open it. The excelize only partially updates these references
-     * currently.
-     * @param sheet The worksheet name
-     * @param row The row number
-     */
-    DuplicateRow(sheet: string, row: number): { error: string | null }
-
-    /**
-     * DuplicateRowTo inserts a copy of specified row by it Excel number to
-     * specified row position moving down exists rows after target position.
-     * Use this method with caution, which will affect changes in references
-     * such as formulas, charts, and so on. If there is any referenced value
-     * of the worksheet, it will cause a file error when you open it. The
-     * excelize only partially updates these references currently.
+     * ExportCSV provides a function to export the worksheet in CSV format by
+     * given worksheet name and the optional CSV options, and returns the
+     * encoded bytes. The cell values will be formatted by the number format
+     * of the cells unless the RawCellValue option is true, and the records
+     * are padded to the same number of fields. For example, export the
+     * worksheet named Sheet1 with semicolon delimiter and the UTF-8 BOM:
+     *
+     * ```typescript
+     * const { buffer, error } = f.ExportCSV('Sheet1', { Delimiter: ';', BOM: true });
+     * ```
      * @param sheet The worksheet name
-     * @param row The source row number
-     * @param row2 The target row number
-     */
-    DuplicateRowTo(sheet: string, row: number, row2: number): { error: string | null }
-
-    /**
-     * GetActiveSheetIndex provides a function to get active sheet index of the
-     * spreadsheet. If not found the active sheet will be return integer 0.
+     * @param opts The CSV options
      */
-    GetActiveSheetIndex(): { index: number, error: string | null }
+    ExportCSV(sheet: string, opts?: CSVOptions): { buffer: Uint8Array, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetAppProps provides a function to get document application properties.
      * @return This is the document application properties.
      */
-    GetAppProps(): { props?: AppProperties, error: string | null };
+    GetAppProps(): { props?: AppProperties, error: string | null, errorInfo?: ErrorInfo };
 
     /**
      * GetBaseColor returns the preferred hex color code by giving hex color
      * code, indexed color, and theme color.
      */
-    GetBaseColor(hexColor: string, indexedColor: number, themeColor?: number): { color?: string, error: string | null };
+    GetBaseColor(hexColor: string, indexedColor: number, themeColor?: number): { color?: string, error: string | null, errorInfo?: ErrorInfo };
 
     /**
      * GetCalcProps provides a function to gets calculation properties.
      */
-    GetCalcProps(): { props: CalcPropsOptions, error: string | null }
+    GetCalcProps(): { props: CalcPropsOptions, error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * GetCellFormula provides a function to get formula from cell by given
-     * worksheet name and cell reference in spreadsheet.
+     * GetCellDate provides a function to get the date and time value of a
+     * cell as a Date object by given worksheet name and cell reference in
+     * spreadsheet. The raw number value of the cell will be converted to the
+     * date and time according to the date system of the workbook (1900 or
+     * 1904 date system), and used as the local date and time of the Date
+     * object. The value will be null if the cell is empty or its value isn't
+     * a number.
      * @param sheet The worksheet name
      * @param cell The cell reference
      */
-    GetCellFormula(sheet: string, cell: string): { formula: string, error: string | null }
+    GetCellDate(sheet: string, cell: string): { value: Date | null, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetCellHyperLink gets a cell hyperlink based on the given worksheet name
@@ -2944,7 +3328,7 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param cell The cell reference
      */
-    GetCellHyperLink(sheet: string, cell: string): { ok: boolean, location: string, error: string | null }
+    GetCellHyperLink(sheet: string, cell: string): { ok: boolean, location: string, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetCellRichText provides a function to get rich text of cell by given
@@ -2952,15 +3336,7 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param cell The cell reference
      */
-    GetCellRichText(sheet: string, cell: string): { runs: RichTextRun[], error: string | null }
-
-    /**
-     * GetCellStyle provides a function to get cell style index by given
-     * worksheet name and cell reference.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     */
-    GetCellStyle(sheet: string, cell: string): { style: number, error: string | null }
+    GetCellRichText(sheet: string, cell: string): { runs: RichTextRun[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetCellType provides a function to get the cell's data type by given
@@ -2968,7 +3344,7 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param cell The cell reference
      */
-    GetCellType(sheet: string, cell: string): { cellType: CellType, error: string | null }
+    GetCellType(sheet: string, cell: string): { cellType: CellType, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetCellValue provides a function to get formatted value from cell by
@@ -2976,36 +3352,14 @@ declare module 'excelize-wasm' {
      * is converted to the 'string' data type. If the cell format can be
      * applied to the value of a cell, the applied value will be returned,
      * otherwise the original value will be returned. All cells' values will be
-     * the same in a merged range.
+     * the same in a merged range. Set the Typed field of the options to true
+     * to get the typed cell value instead of the formatted string.
      * @param sheet The worksheet name
      * @param cell The cell reference
      * @param opts The options for get cell value
      */
-    GetCellValue(sheet: string, cell: string, opts?: Options): { value: string, error: string | null }
-
-    /**
-     * GetColOutlineLevel provides a function to get outline level of a single
-     * column by given worksheet name and column name.
-     * @param sheet The worksheet name
-     * @param col The column name
-     */
-    GetColOutlineLevel(sheet: string, col: string): { level: number, error: string | null }
-
-    /**
-     * GetColStyle provides a function to get column style ID by given
-     * worksheet name and column name.
-     * @param sheet The worksheet name
-     * @param col The column name
-     */
-    GetColStyle(sheet: string, col: string): { style: number, error: string | null }
-
-    /**
-     * GetColVisible provides a function to get visible of a single column by
-     * given worksheet name and column name.
-     * @param sheet The worksheet name
-     * @param col The column name
-     */
-    GetColVisible(sheet: string, col: string): { visible: boolean, error: string | null }
+    GetCellValue(sheet: string, cell: string, opts: TypedOptions): { value: CellValue, error: string | null, errorInfo?: ErrorInfo }
+    GetCellValue(sheet: string, cell: string, opts?: Options): { value: string, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetColWidth provides a function to get column width by given worksheet
@@ -3013,60 +3367,79 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param col The column name
      */
-    GetColWidth(sheet: string, col: string): { width: number, error: string | null }
+    GetColWidth(sheet: string, col: string): { width: number, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetCols gets the value of all cells by columns on the worksheet based on
      * the given worksheet name, returned as a two-dimensional array, where
      * the value of the cell is converted to the `string` type. If the cell
      * format can be applied to the value of the cell, the applied value will
-     * be used, otherwise the original value will be used.
+     * be used, otherwise the original value will be used. Set the Typed
+     * field of the options to true to get the typed cell values instead of
+     * the formatted strings.
      * @param sheet The worksheet name
      * @param opts The options for get column cells
      */
-    GetCols(sheet: string, opts?: Options): { result: string[][], error: string | null }
+    GetCols(sheet: string, opts: TypedOptions): { result: CellValue[][], error: string | null, errorInfo?: ErrorInfo }
+    GetCols(sheet: string, opts?: Options): { result: string[][], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetComments retrieves all comments in a worksheet by given worksheet
      * name.
      * @param sheet The worksheet name
      */
-    GetComments(sheet: string): { comments: Comment[], error: string | null }
+    GetComments(sheet: string): { comments: Comment[], error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetConditionalFormats returns conditional format settings by given
+     * worksheet name. The result is a map of the range references to the
+     * conditional formatting rules, which could be applied to other ranges by
+     * the SetConditionalFormat function, for example, copy the conditional
+     * formats of the worksheet to another:
+     *
+     * const { formats, error } = f.GetConditionalFormats('Sheet1');
+     * for (const [reference, opts] of Object.entries(formats)) {
+     *   f.SetConditionalFormat('Sheet2', reference, opts);
+     * }
+     *
+     * @param sheet The worksheet name
+     */
+    GetConditionalFormats(sheet: string): { formats: { [reference: string]: ConditionalFormatOptions[] }, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetConditionalStyle returns conditional format style definition by
      * specified style index.
      * @param styleID The style ID
      */
-    GetConditionalStyle(styleID: number): { style: Style, error: string | null }
+    GetConditionalStyle(styleID: number): { style: Style, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetCustomProps provides a function to get custom file properties.
      */
-    GetCustomProps(): { props: CustomProperty[], error: string | null }
+    GetCustomProps(): { props: CustomProperty[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetDataValidations returns data validations list by given worksheet name.
      * @param sheet The worksheet name
      */
-    GetDataValidations(sheet: string): { dataValidation: DataValidation[], error: string | null }
+    GetDataValidations(sheet: string): { dataValidation: DataValidation[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetDefaultFont provides the default font name currently set in the
      * workbook. The spreadsheet generated by excelize default font is Calibri.
      */
-    GetDefaultFont(): { fontName: string, error: string | null }
+    GetDefaultFont(): { fontName: string, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetDefinedName provides a function to get the defined names of the
      * workbook or worksheet.
      */
-    GetDefinedName(): { definedNames: DefinedName[], error: string | null }
+    GetDefinedName(): { definedNames: DefinedName[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetDocProps provides a function to get document core properties.
      */
-    GetDocProps(): { props: DocProperties, error: string | null }
+    GetDocProps(): { props: DocProperties, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetFormControls retrieves all form controls in a worksheet by a given
@@ -3074,7 +3447,7 @@ declare module 'excelize-wasm' {
      * width and height of the form controls currently.
      * @param sheet The worksheet name
      */
-    GetFormControls(sheet: string): { formControls: FormControl[], error: string | null }
+    GetFormControls(sheet: string): { formControls: FormControl[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetHyperLinkCells returns cell references which contain hyperlinks in a
@@ -3086,14 +3459,14 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param linkType The cell hyperlink type
      */
-    GetHyperLinkCells(sheet: string, linkType: string): { result: string[], error: string | null }
+    GetHyperLinkCells(sheet: string, linkType: string): { result: string[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetHeaderFooter provides a function to get worksheet header and footer by
      * given worksheet name.
      * @param sheet The worksheet name
      */
-    GetHeaderFooter(sheet: string): { opts: HeaderFooterOptions, error: string | null }
+    GetHeaderFooter(sheet: string): { opts: HeaderFooterOptions, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetMergeCells provides a function to get all merged cells from a specific
@@ -3113,20 +3486,26 @@ declare module 'excelize-wasm' {
      * ```
      * @param sheet The worksheet name
      */
-    GetMergeCells(sheet: string, withoutValues?: boolean): { mergeCells: MergeCell[], error: string | null }
+    GetMergeCells(sheet: string, withoutValues?: boolean): { mergeCells: MergeCell[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetPageLayout provides a function to gets worksheet page layout.
      * @param sheet The worksheet name
      */
-    GetPageLayout(sheet: string): { opts: PageLayoutOptions, error: string | null }
+    GetPageLayout(sheet: string): { opts: PageLayoutOptions, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetPageMargins provides a function to get worksheet page margins.
+     * @param sheet The worksheet name
+     */
+    GetPageMargins(sheet: string): { opts: PageLayoutMarginsOptions, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetPanes provides a function to get freeze panes, split panes, and
      * worksheet views by given worksheet name.
      * @param sheet The worksheet name
      */
-    GetPanes(sheet: string): { panes: Panes, error: string | null }
+    GetPanes(sheet: string): { panes: Panes, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetPictures provides a function to get picture meta info and raw content
@@ -3163,62 +3542,99 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param cell The cell reference
      */
-    GetPictures(sheet: string, cell: string): { pictures: Picture[], error: string | null }
+    GetPictures(sheet: string, cell: string): { pictures: Picture[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetPictureCells returns all picture cell references in a worksheet by a
      * specific worksheet name.
      * @param sheet The worksheet name
      */
-    GetPictureCells(sheet: string): { cells: string[], error: string | null }
+    GetPictureCells(sheet: string): { cells: string[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetPivotTables returns all pivot table definitions in a worksheet by
      * given worksheet name.
      */
-    GetPivotTables(sheet: string): { opts: PivotTableOptions[], error: string | null }
+    GetPivotTables(sheet: string): { opts: PivotTableOptions[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * GetRowHeight provides a function to get row height by given worksheet
-     * name and row number. For example, get the height of the first row in
-     * Sheet1:
+     * GetRangeValues provides a function to get the raw numeric values of
+     * the given range by given worksheet name and range reference, the values
+     * will be returned as a Float64Array in row-major order, and a parallel
+     * validity bitmap in the Uint8Array, the bit (i & 7) of the byte (i >> 3)
+     * specifies if the i-th value is a valid number. The value of the empty
+     * cell or the cell with a non-numeric raw value or a boolean value will
+     * be NaN with the validity bit unset. The range will be clamped to the
+     * last row and column with cells in it, and the rows and cols in the
+     * result specify the size of the clamped range. For example, get the
+     * values of the range B2:B100 on Sheet1:
      *
      * ```typescript
-     * const { height, error } = f.GetRowHeight('Sheet1', 1);
+     * const { values, valid, error } = f.GetRangeValues('Sheet1', 'B2:B100', { As: 'float64' });
+     * if (error) {
+     *   console.log(error);
+     *   return;
+     * }
+     * for (let i = 0; i < values.length; i++) {
+     *   if (valid[i >> 3] & (1 << (i & 7))) {
+     *     console.log(values[i]);
+     *   }
+     * }
      * ```
      *
      * @param sheet The worksheet name
-     * @param row The row number
+     * @param range The range reference
+     * @param opts The options for get the range values
      */
-    GetRowHeight(sheet: string, row: number): { height: number, error: string | null }
+    GetRangeValues(sheet: string, range: string, opts?: RangeValuesOptions & { ByColumn?: false }): { values: Float64Array, valid: Uint8Array, rows: number, cols: number, error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * GetRowOutlineLevel provides a function to get outline level number of a
-     * single row by given worksheet name and Excel row number.
+     * GetRangeValues provides a function to get the raw numeric values of
+     * each column in the given range, the values and validity bitmaps of each
+     * column will be returned in arrays if the ByColumn option is true.
      * @param sheet The worksheet name
-     * @param row The row number
+     * @param range The range reference
+     * @param opts The options for get the range values
      */
-    GetRowOutlineLevel(sheet: string, row: number): { level: number, error: string | null }
+    GetRangeValues(sheet: string, range: string, opts: RangeValuesOptions & { ByColumn: true }): { values: Float64Array[], valid: Uint8Array[], rows: number, cols: number, error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * GetRowVisible provides a function to get visible of a single row by
-     * given worksheet name and Excel row number. For example, get visible state
-     * of row 2 in Sheet1:
+     * GetRecords provides a function to get the rows of the worksheet as
+     * records keyed by the header cell text by given worksheet name and the
+     * optional records options. The cells of the merged header cell will get
+     * the value of the merged cell, the duplicate headers will be suffixed
+     * with the sequence number, such as 'Name_2', and the empty headers will
+     * be replaced with the column name. For example, get the typed records
+     * of the range A1:C10 in Sheet1:
+     *
+     * ```typescript
+     * const { records, error } = f.GetRecords('Sheet1', { Range: 'A1:C10', Typed: true });
+     * ```
+     * @param sheet The worksheet name
+     * @param opts The records options
+     */
+    GetRecords(sheet: string, opts: RecordsOptions & { Typed: true }): { records: { [header: string]: CellValue }[], error: string | null, errorInfo?: ErrorInfo }
+    GetRecords(sheet: string, opts?: RecordsOptions): { records: { [header: string]: string }[], error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetRowHeight provides a function to get row height by given worksheet
+     * name and row number. For example, get the height of the first row in
+     * Sheet1:
      *
      * ```typescript
-     * const { visible, error } = f.GetRowVisible('Sheet1', 2);
+     * const { height, error } = f.GetRowHeight('Sheet1', 1);
      * ```
      *
      * @param sheet The worksheet name
      * @param row The row number
      */
-    GetRowVisible(sheet: string, row: number): { visible: boolean, error: string | null }
+    GetRowHeight(sheet: string, row: number): { height: number, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetSheetDimension provides the method to get the used range of the worksheet.
      * @param sheet The worksheet name
      */
-    GetSheetDimension(sheet: string): { dimension: string, error: string | null }
+    GetSheetDimension(sheet: string): { dimension: string, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetRows return all the rows in a sheet by given worksheet name, returned
@@ -3247,17 +3663,18 @@ declare module 'excelize-wasm' {
      * ```
      *
      * @param sheet The worksheet name
-     * @param opts The options for get rows
-     */
-    GetRows(sheet: string, opts?: Options): { result: string[][], error: string | null }
-
-    /**
-     * GetSheetIndex provides a function to get a sheet index of the workbook
-     * by the given sheet name. If the given sheet name is invalid or sheet
-     * doesn't exist, it will return an integer type value -1.
+     * Set the Typed field of the options to true to get the typed cell
+     * values instead of the formatted strings:
+     *
+     * ```typescript
+     * const { result, error } = f.GetRows('Sheet1', { Typed: true });
+     * ```
+     *
      * @param sheet The worksheet name
+     * @param opts The options for get rows
      */
-    GetSheetIndex(sheet: string): { index: number, error: string | null }
+    GetRows(sheet: string, opts: TypedOptions): { result: CellValue[][], error: string | null, errorInfo?: ErrorInfo }
+    GetRows(sheet: string, opts?: Options): { result: string[][], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetSheetList provides a function to get worksheets, chart sheets, and
@@ -3269,21 +3686,13 @@ declare module 'excelize-wasm' {
      * GetSheetMap provides a function to get worksheets, chart sheets, dialog
      * sheets ID and name map of the workbook.
      */
-    GetSheetMap(): { sheets: Map<string,string>, error: string | null }
-
-    /**
-     * GetSheetName provides a function to get the sheet name of the workbook
-     * by the given sheet index. If the given sheet index is invalid, it will
-     * return an empty string.
-     * @param index The sheet index
-     */
-    GetSheetName(index: number): { name: string, error: string | null }
+    GetSheetMap(): { sheets: Map<string,string>, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetSheetProps provides a function to get worksheet properties.
      * @param sheet The worksheet name
      */
-    GetSheetProps(sheet: string): { props: SheetPropsOptions, error: string | null }
+    GetSheetProps(sheet: string): { props: SheetPropsOptions, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetSheetProtection provides a function to get worksheet protection
@@ -3291,7 +3700,7 @@ declare module 'excelize-wasm' {
      * will always be empty.
      * @param sheet The worksheet name
      */
-    GetSheetProtection(sheet: string): { opts: SheetProtectionOptions, error: string | null }
+    GetSheetProtection(sheet: string): { opts: SheetProtectionOptions, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetSheetView gets the value of sheet view options. The viewIndex may be
@@ -3299,14 +3708,14 @@ declare module 'excelize-wasm' {
      * @param sheet The worksheet name
      * @param viewIndex The sheet view index
      */
-    GetSheetView(sheet: string, viewIndex: number): { opts: ViewOptions, error: string | null }
+    GetSheetView(sheet: string, viewIndex: number): { opts: ViewOptions, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetSheetVisible provides a function to get worksheet visible by given
      * worksheet name.
      * @param sheet The worksheet name
      */
-    GetSheetVisible(sheet: string): { visible: boolean, error: string | null }
+    GetSheetVisible(sheet: string): { visible: boolean, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetSlicers provides the method to get all slicers in a worksheet by a
@@ -3314,119 +3723,54 @@ declare module 'excelize-wasm' {
      * the height, width, and graphic options of the slicer shape currently.
      * @param sheet The worksheet name
      */
-    GetSlicers(sheet: number): { slicers: SlicerOptions[], error: string | null }
+    GetSlicers(sheet: number): { slicers: SlicerOptions[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetStyle provides a function to get style definition by given style index.
      * @param styleID The style ID
      */
-    GetStyle(styleID: number): { style: Style, error: string | null }
+    GetStyle(styleID: number): { style: Style, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetTables provides the method to get all tables in a worksheet by given
      * worksheet name.
      * @param sheet The worksheet name
      */
-    GetTables(sheet: string): { tables: TableOptions[], error: string | null }
+    GetTables(sheet: string): { tables: TableOptions[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GetWorkbookProps provides a function to gets workbook properties.
      */
-    GetWorkbookProps(): { props: WorkbookPropsOptions, error: string | null }
+    GetWorkbookProps(): { props: WorkbookPropsOptions, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * GroupSheets provides a function to group worksheets by given worksheets
      * name. Group worksheets must contain an active worksheet.
      * @param sheets The worksheet names
      */
-    GroupSheets(sheets: string[]): { error: string | null }
+    GroupSheets(sheets: string[]): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * InsertCols provides a function to insert new columns before the given
-     * column name and number of columns.
-     *
-     * Use this method with caution, which will affect changes in references
-     * such as formulas, charts, and so on. If there is any referenced value
-     * of the worksheet, it will cause a file error when you open it. The
-     * excelize only partially updates these references currently.
-     * @param sheet The worksheet name
-     * @param col The base column name
-     * @param n The insert columns count
-     */
-    InsertCols(sheet: string, col: string, n: number): { error: string | null }
-
-    /**
-     * InsertPageBreak create a page break to determine where the printed page
-     * ends and where begins the next one by given worksheet name and cell, so
-     * the content before the page break will be printed on one page and after
-     * the page break on another.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     */
-    InsertPageBreak(sheet: string, cell: string): { error: string | null }
-
-    /**
-     * InsertRows provides a function to insert new rows after the given Excel
-     * row number starting from 1 and number of rows.
-     *
-     * Use this method with caution, which will affect changes in references
-     * such as formulas, charts, and so on. If there is any referenced value
-     * of the worksheet, it will cause a file error when you open it. The
-     * excelize only partially updates these references currently.
-     * @param sheet The worksheet name
-     * @param row The base row number
-     * @param n Insert rows count
-     */
-    InsertRows(sheet: string, row: number, n: number): { error: string | null }
-
-    /**
-     * MergeCell provides a function to merge cells by given range reference
-     * and sheet name. Merging cells only keeps the upper-left cell value, and
-     * discards the other values. For example create a merged cell of D3:E9 on
-     * Sheet1:
+     * ImportCSV provides a function to import the CSV data into the worksheet
+     * by given worksheet name, top-left cell reference, CSV bytes or string
+     * and the optional import options. The fields are parsed in RFC 4180
+     * quoting, and the empty fields will be skipped. The fields will be
+     * written as strings unless the InferTypes option is true, the numbers
+     * with leading zeros or more than 15 significant digits will be kept as
+     * strings. The Blob and ReadableStream will be read incrementally, and a
+     * promise of the result will be returned for them. For example, import
+     * the TSV data into the worksheet named Sheet1 from cell A1:
      *
      * ```typescript
-     * const { error } = f.MergeCell('Sheet1', 'D3', 'E9');
+     * const { error } = f.ImportCSV('Sheet1', 'A1', 'Name\tScore\nBob\t95.5%', { InferTypes: true });
      * ```
-     *
-     * If you create a merged cell that overlaps with another existing merged
-     * cell, those merged cells that already exist will be removed. The cell
-     * references tuple after merging in the following range will be: A1
-     * (x3,y1) D1(x2,y1) A8(x3,y4) D8(x2,y4)
-     *
-     *                  B1(x1,y1)      D1(x2,y1)
-     *                +------------------------+
-     *                |                        |
-     *      A4(x3,y3) |    C4(x4,y3)           |
-     *     +------------------------+          |
-     *     |          |             |          |
-     *     |          |B5(x1,y2)    | D5(x2,y2)|
-     *     |          +------------------------+
-     *     |                        |
-     *     |A8(x3,y4)      C8(x4,y4)|
-     *     +------------------------+
      * @param sheet The worksheet name
-     * @param topLeftCell The top-left cell reference
-     * @param bottomRightCell The right-bottom cell reference
-     */
-    MergeCell(sheet: string, topLeftCell: string, bottomRightCell: string): { error: string | null }
-
-    /**
-     * MoveSheet moves a sheet to a specified position in the workbook. The
-     * function moves the source sheet before the target sheet. After moving,
-     * other sheets will be shifted to the left or right. If the sheet is
-     * already at the target position, the function will not perform any action.
-     * Not that this function will be ungroup all sheets after moving. For
-     * example, move Sheet2 before Sheet1:
-     *
-     * ```typescript
-     * const { error } = f.MoveSheet('Sheet2', 'Sheet1');
-     * ```
-     *
-     * @param source The source sheet name
-     * @param target The target sheet name
+     * @param cell The top-left cell reference
+     * @param data The CSV bytes or string
+     * @param opts The CSV import options
      */
-    MoveSheet(source: string, target: string): { error: string | null }
+    ImportCSV(sheet: string, cell: string, data: BinaryStream, opts?: CSVImportOptions): Promise<{ error: string | null, errorInfo?: ErrorInfo }>
+    ImportCSV(sheet: string, cell: string, data: BinaryData | string, opts?: CSVImportOptions): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * NewConditionalStyle provides a function to create style for conditional
@@ -3435,7 +3779,7 @@ declare module 'excelize-wasm' {
      * only support to set font, fills, alignment and borders currently.
      * @param style
      */
-    NewConditionalStyle(style: Style): { style: number, error: string | null }
+    NewConditionalStyle(style: Style): { style: number, error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * NewSheet provides the function to create a new sheet by given a
@@ -3444,92 +3788,111 @@ declare module 'excelize-wasm' {
      * worksheet named `Sheet1` will be created.
      * @param sheet The worksheet name
      */
-    NewSheet(sheet: string): { index: number, error: string | null }
+    NewSheet(sheet: string): { index: number, error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * ProtectSheet provides a function to prevent other users from
-     * accidentally or deliberately changing, moving, or deleting data in a
-     * worksheet. The optional field AlgorithmName specified hash algorithm,
-     * support XOR, MD4, MD5, SHA-1, SHA2-56, SHA-384, and SHA-512 currently,
-     * if no hash algorithm specified, will be using the XOR algorithm as
-     * default. For example, protect Sheet1 with protection settings:
+     * NewStreamWriter returns stream writer struct by given worksheet name
+     * used for writing data on a new existing empty worksheet with large
+     * amounts of data. Note that after writing data with the stream writer
+     * for the worksheet, you must call the 'Flush' method to end the
+     * streaming writing process, ensure that the order of row numbers is
+     * ascending when set rows, and the normal mode functions and stream mode
+     * functions can not be work mixed to writing data on the worksheets. For
+     * example, set data for worksheet of size 102400 rows x 50 columns with
+     * numbers:
      *
      * ```typescript
-     * const { error } = f.ProtectSheet('Sheet1', {
-     *   AlgorithmName:       'SHA-512',
-     *   Password:            'password',
-     *   SelectLockedCells:   true,
-     *   SelectUnlockedCells: true,
-     *   EditScenarios:       true,
-     * });
+     * const sw = f.NewStreamWriter('Sheet1');
+     * if (sw.error) {
+     *   console.log(sw.error);
+     *   return;
+     * }
+     * for (let r = 2; r <= 102400; r++) {
+     *   const row = [];
+     *   for (let c = 0; c < 50; c++) {
+     *     row.push(Math.random() * 640000);
+     *   }
+     *   const { error } = sw.SetRow(`A${r}`, row);
+     *   if (error) {
+     *     console.log(error);
+     *     return;
+     *   }
+     * }
+     * const { error } = sw.Flush();
      * ```
      *
      * @param sheet The worksheet name
-     * @param opts The worksheet protection options
-     */
-    ProtectSheet(sheet: string, opts: SheetProtectionOptions): { error: string | null }
-
-    /**
-     * ProtectWorkbook provides a function to prevent other users from viewing
-     * hidden worksheets, adding, moving, deleting, or hiding worksheets, and
-     * renaming worksheets in a workbook. The optional field AlgorithmName
-     * specified hash algorithm, support XOR, MD4, MD5, SHA-1, SHA2-56,
-     * SHA-384, and SHA-512 currently, if no hash algorithm specified, will be
-     * using the XOR algorithm as default. The generated workbook only works on
-     * Microsoft Office 2007 and later. For example, protect workbook with
-     * protection settings:
-     *
-     * ```typescript
-     * const { error } = f.ProtectWorkbook({
-     *   Password:      'password',
-     *   LockStructure: true,
-     * });
-     * ```
-     *
-     * @param opts The workbook protection options
      */
-    ProtectWorkbook(opts: WorkbookProtectionOptions): { error: string | null }
+    NewStreamWriter(sheet: string): StreamWriter & { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * NewStyle provides a function to create the style for cells by given
      * options. Note that the color field uses RGB color code.
      * @param style The style options
      */
-    NewStyle(style: Style): { style: number, error: string | null }
+    NewStyle(style: Style): { style: number, error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * RemoveCol provides a function to remove single column by given worksheet
-     * name and column index.
+     * RenderHTML provides a function to render the cells of the worksheet as
+     * an HTML table with inline styles by given worksheet name, range
+     * reference and the optional render options. The used range of the
+     * worksheet will be rendered if the range reference is empty. The fonts,
+     * fills, borders, alignments and number formats of the cells, the merged
+     * cells, column widths, row heights, hyperlinks and rich text will be
+     * rendered, and the hidden rows and columns will be omitted unless the
+     * ShowHidden option is true. Only the hyperlinks with the http, https,
+     * ftp and mailto schemes will be rendered as links to external
+     * resources, others will be rendered as the fragment of the location.
+     * Rendering doesn't change the worksheet, the empty cells after the last
+     * cell with value in a row will be rendered with the style of the row or
+     * column. For example, render the range A1:D10 of Sheet1 for preview:
      *
-     * Use this method with caution, which will affect changes in references
-     * such as formulas, charts, and so on. If there is any referenced value
-     * of the worksheet, it will cause a file error when you open it. The
-     * excelize only partially updates these references currently.
-     * @param sheet The worksheet name
-     * @param col The column name
-     */
-    RemoveCol(sheet: string, col: string): { error: string | null }
-
-    /**
-     * RemovePageBreak remove a page break by given worksheet name and cell
-     * reference.
+     * ```typescript
+     * const { html, error } = f.RenderHTML('Sheet1', 'A1:D10');
+     * if (!error) {
+     *   document.getElementById('preview').innerHTML = html;
+     * }
+     * ```
      * @param sheet The worksheet name
-     * @param cell The cell reference
+     * @param range The range reference
+     * @param opts The render options
      */
-    RemovePageBreak(sheet: string, cell: string): { error: string | null }
+    RenderHTML(sheet: string, range: string, opts?: HTMLOptions): { html: string, error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * RemoveRow provides a function to remove single row by given worksheet
-     * name and Excel row number.
+     * Rows returns a rows iterator, used for streaming reading data for a
+     * worksheet with a large data. This function is concurrency safe. For
+     * example:
+     *
+     * ```typescript
+     * const rows = f.Rows('Sheet1');
+     * if (rows.error) {
+     *   console.log(rows.error);
+     *   return;
+     * }
+     * while (rows.Next()) {
+     *   const { result, error } = rows.Columns();
+     *   if (error) {
+     *     console.log(error);
+     *     break;
+     *   }
+     *   console.log(result);
+     * }
+     * const { error } = rows.Close();
+     * ```
+     *
+     * The returned iterator can also be iterated by the "for await...of"
+     * statement, and it will be closed when the iteration ends:
+     *
+     * ```typescript
+     * for await (const row of f.Rows('Sheet1')) {
+     *   console.log(row);
+     * }
+     * ```
      *
-     * Use this method with caution, which will affect changes in references
-     * such as formulas, charts, and so on. If there is any referenced value
-     * of the worksheet, it will cause a file error when you open it. The
-     * excelize only partially updates these references currently.
      * @param sheet The worksheet name
-     * @param row The row number
      */
-    RemoveRow(sheet: string, row: number): { error: string | null }
+    Rows(sheet: string): Rows & { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * SearchSheet provides a function to get cell reference by given worksheet
@@ -3541,177 +3904,12 @@ declare module 'excelize-wasm' {
      * @param value The cell value to search
      * @param reg Specifies if search with regular expression
      */
-    SearchSheet(sheet: string, value: string, reg?: boolean): { result: string[], error: string | null }
-
-    /**
-     * SetActiveSheet provides a function to set the default active sheet of
-     * the workbook by a given index. Note that the active index is different
-     * from the ID returned by function GetSheetMap(). It should be greater
-     * than or equal to 0 and less than the total worksheet numbers.
-     * @param index The sheet index
-     */
-    SetActiveSheet(index: number): { error: string | null }
-
-    /**
-     * SetAppProps provides a function to set document application properties.
-     * The properties that can be set are:
-     *
-     *      Property          | Description
-     *     -------------------+---------------------------------------------------
-     *      Application       | The name of the application that created this
-     *                        | document.
-     *                        |
-     *      ScaleCrop         | Indicates the display mode of the document
-     *                        | thumbnail. Set this element to 'true' to enable
-     *                        | scaling of the document thumbnail to the display.
-     *                        | Set this element to 'false' to enable cropping of
-     *                        | the document thumbnail to show only sections that
-     *                        | will fit the display.
-     *                        |
-     *      DocSecurity       | Security level of a document as a numeric value.
-     *                        | Document security is
-     *                        |
-     *                        | defined as:
-     *                        | 1 - Document is password protected.
-     *                        | 2 - Document is recommended to be opened as read-only.
-     *                        | 3 - Document is enforced to be opened as read-only.
-     *                        | 4 - Document is locked for annotation.
-     *                        |
-     *      Company           | The name of a company associated with the document.
-     *                        |
-     *      LinksUpToDate     | Indicates whether hyperlinks in a document are
-     *                        | up-to-date. Set this
-     *                        | element to 'true' to indicate that hyperlinks are
-     *                        | updated. Set this element to 'false' to indicate
-     *                        | that hyperlinks are outdated.
-     *                        |
-     *      HyperlinksChanged | Specifies that one or more hyperlinks in this part
-     *                        | were updated exclusively in this part by a
-     *                        | producer. The next producer to open this document
-     *                        | shall update the hyperlink relationships with the
-     *                        | new hyperlinks specified in this part.
-     *                        |
-     *      AppVersion        | Specifies the version of the application which
-     *                        | produced this document. The content of this
-     *                        | element shall be of the form XX.YYYY where X and Y
-     *                        | represent numerical values, or the document shall
-     *                        | be considered non-conformant.
-     *
-     * For example:
-     *
-     * ```typescript
-     * const { error } = f.SetAppProps({
-     *   Application:       'Microsoft Excel',
-     *   ScaleCrop:         true,
-     *   DocSecurity:       3,
-     *   Company:           'Company Name',
-     *   LinksUpToDate:     true,
-     *   HyperlinksChanged: true,
-     *   AppVersion:        '16.0000',
-     * });
-     * ```
-     *
-     * @param props The application properties
-     */
-    SetAppProps(props: AppProperties): { error: string | null }
-
-    /**
-     * SetCalcProps provides a function to sets calculation properties. Optional
-     * value of "CalcMode" property is: "manual", "auto" or "autoNoTable".
-     * Optional value of "RefMode" property is: "A1" or "R1C1".
-     * @param props The application properties
-     */
-    SetCalcProps(opts: CalcPropsOptions): { error: string | null }
-
-    /**
-     * SetCellBool provides a function to set bool type value of a cell by
-     * given worksheet name, cell reference and cell value.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param value The cell value to be write
-     */
-    SetCellBool(sheet: string, cell: string, value: boolean): { error: string | null }
+    SearchSheet(sheet: string, value: string, reg?: boolean): { result: string[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetCellDefault provides a function to set string type value of a cell as
-     * default format without escaping the cell.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param value The cell value to be write
-     */
-    SetCellDefault(sheet: string, cell: string, value: string): { error: string | null }
-
-    /**
-     * SetCellFloat sets a floating point value into a cell. The precision
-     * parameter specifies how many places after the decimal will be shown
-     * while -1 is a special value that will use as many decimal places as
-     * necessary to represent the number. bitSize is 32 or 64 depending on if
-     * a float32 or float64 was originally used for the value.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param value The cell value to be write
-     * @param precision Specifies how many places after the decimal will be
-     *  shown
-     * @param bitSize BitSize is 32 or 64 depending on if a float32 or float64
-     *  was originally used for the value
-     */
-    SetCellFloat(sheet: string, cell: string, value: number, precision: number, bitSize: number): { error: string | null }
-
-    /**
-     * SetCellFormula provides a function to set formula on the cell is taken
-     * according to the given worksheet name and cell formula settings. The
-     * result of the formula cell can be calculated when the worksheet is
-     * opened by the Office Excel application or can be using the
-     * "CalcCellValue" function also can get the calculated cell value. If the
-     * Excel application doesn't calculate the formula automatically when the
-     * workbook has been opened, please call "UpdateLinkedValue" after setting
-     * the cell formula functions.
-     *
-     * Example 1, set normal formula "=SUM(A1,B1)" for the cell "A3" on "Sheet1":
-     *
-     * ```typescript
-     * const { error } = f.SetCellFormula('Sheet1', 'A3', '=SUM(A1,B1)');
-     * ```
-     *
-     * Example 2, set one-dimensional vertical constant array (column array)
-     * formula "1,2,3" for the cell "A3" on "Sheet1":
-     *
-     * ```typescript
-     * const { error } = f.SetCellFormula('Sheet1', 'A3', '={1;2;3}');
-     * ```
-     *
-     * Example 3, set one-dimensional horizontal constant array (row array)
-     * formula '"a","b","c"' for the cell "A3" on "Sheet1":
-     *
-     * ```typescript
-     * const { error } = f.SetCellFormula('Sheet1', 'A3', '={"a","b","c"}');
-     * ```
-     *
-     * Example 4, set two-dimensional constant array formula '{1,2,"a","b"}' for
-     * the cell "A3" on "Sheet1":
-     *
-     * ```typescript
-     * const { error } = f.SetCellFormula('Sheet1', 'A3', '={1,2;"a","b"}',
-     *     {Ref: 'A3:A3', Type: 'array'});
-     * ```
-     *
-     * Example 5, set range array formula "A1:A2" for the cell "A3" on "Sheet1":
-     *
-     * ```typescript
-     * const { error } = f.SetCellFormula('Sheet1', 'A3', '=A1:A2',
-     *     {Ref: 'A3:A3', Type: 'array'});
-     * ```
-     *
-     * Example 6, set shared formula "=A1+B1" for the cell "C1:C5"
-     * on "Sheet1", "C1" is the master cell:
-     *
-     * ```typescript
-     * const { error } = f.SetCellFormula('Sheet1', 'C1', '=A1+B1',
-     *     {Ref: 'C1:C5', Type: 'shared'});
-     * ```
-     *
-     * Example 7, set table formula "=SUM(Table1[[A]:[B]])" for the cell "C2"
-     * on "Sheet1":
+     * SetCellRichText provides a function to set cell with rich text by given
+     * worksheet name, cell reference and rich text runs. For example, set rich
+     * text on the A1 cell of the worksheet named Sheet1:
      *
      * ```typescript
      * const { init } = require('excelize-wasm');
@@ -3719,104 +3917,12 @@ declare module 'excelize-wasm' {
      *
      * init('./node_modules/excelize-wasm/excelize.wasm.gz').then((excelize) => {
      *   const f = excelize.NewFile();
-     *   [
-     *     ['A', 'B', 'C'],
-     *     [1, 2],
-     *   ].forEach((row, idx) => {
-     *     var { error } = f.SetSheetRow('Sheet1', `A${idx + 1}`, row);
-     *     if (error) {
-     *       console.log(error);
-     *       return;
-     *     }
-     *   });
-     *   var { error } = f.AddTable('Sheet1', 'A1:C2', {
-     *     Name: 'Table1',
-     *     StyleName: 'TableStyleMedium2',
-     *   });
-     *   if (error) {
-     *     console.log(error);
-     *     return;
-     *   }
-     *   var { error } = f.SetCellFormula('Sheet1', 'C2', '=SUM(Table1[[A]:[B]])', {
-     *     Type: 'dataTable',
-     *   });
+     *   var { error } = f.SetRowHeight('Sheet1', 1, 35);
      *   if (error) {
      *     console.log(error);
      *     return;
      *   }
-     *   // Save spreadsheet by the given path.
-     *   var { buffer, error } = f.WriteToBuffer();
-     *   if (error) {
-     *     console.log(error);
-     *     return;
-     *   }
-     *   fs.writeFile('Book1.xlsx', buffer, 'binary', (error) => {
-     *     if (error) {
-     *       console.log(error);
-     *     }
-     *   });
-     * });
-     * ```
-     *
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param formula The cell formula
-     * @param opts The formula options
-     */
-    SetCellFormula(sheet: string, cell: string, formula: string, opts?: FormulaOptions): { error: string | null }
-
-    /**
-     * SetCellHyperLink provides a function to set cell hyperlink by given
-     * worksheet name and link URL address. LinkType defines three types of
-     * hyperlink "External" for website or "Location" for moving to one of cell
-     * in this workbook or "None" for remove hyperlink. Maximum limit hyperlinks
-     * in a worksheet is 65530. This function is only used to set the hyperlink
-     * of the cell and doesn't affect the value of the cell. If you need to set
-     * the value of the cell, please use the other functions such as
-     * `SetCellStyle` or `SetSheetRow`.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param link The hyperlink
-     * @param linkType The hyperlink type
-     * @param opts The hyperlink options
-     */
-    SetCellHyperLink(sheet: string, cell: string, link: string, linkType: string, opts?: HyperlinkOpts): { error: string | null }
-
-    /**
-     * SetCellInt provides a function to set int type value of a cell by given
-     * worksheet name, cell reference and cell value.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param value The cell value to be write
-     */
-    SetCellInt(sheet: string, cell: string, value: number): { error: string | null }
-
-    /**
-     * SetCellUint provides a function to set uint type value of a cell by given
-     * worksheet name, cell reference and cell value.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param value The cell value to be write
-     */
-    SetCellUint(sheet: string, cell: string, value: number): { error: string | null }
-
-    /**
-     * SetCellRichText provides a function to set cell with rich text by given
-     * worksheet name, cell reference and rich text runs. For example, set rich
-     * text on the A1 cell of the worksheet named Sheet1:
-     *
-     * ```typescript
-     * const { init } = require('excelize-wasm');
-     * const fs = require('fs');
-     *
-     * init('./node_modules/excelize-wasm/excelize.wasm.gz').then((excelize) => {
-     *   const f = excelize.NewFile();
-     *   var { error } = f.SetRowHeight('Sheet1', 1, 35);
-     *   if (error) {
-     *     console.log(error);
-     *     return;
-     *   }
-     *   var { error } = f.SetColWidth('Sheet1', 'A', 'A', 44);
+     *   var { error } = f.SetColWidth('Sheet1', 'A', 'A', 44);
      *   if (error) {
      *     console.log(error);
      *     return;
@@ -3931,37 +4037,16 @@ declare module 'excelize-wasm' {
      * @param cell The cell reference
      * @param runs The rich text runs
      */
-    SetCellRichText(sheet: string, cell: string, runs: RichTextRun[]): { error: string | null }
-
-    /**
-     * SetCellStr provides a function to set string type value of a cell. Total
-     * number of characters that a cell can contain 32767 characters.
-     * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param value The cell value to be write
-     */
-    SetCellStr(sheet: string, cell: string, value: string): { error: string | null }
-
-    /**
-     * SetCellStyle provides a function to add style attribute for cells by
-     * given worksheet name, range reference and style ID. Note that
-     * diagonalDown and diagonalUp type border should be use same color in the
-     * same range. SetCellStyle will overwrite the existing styles for the
-     * cell, it won't append or merge style with existing styles.
-     * @param sheet The worksheet name
-     * @param topLeftCell The top-left cell reference
-     * @param bottomRightCell The right-bottom cell reference
-     * @param styleID The style ID
-     */
-    SetCellStyle(sheet: string, topLeftCell: string, bottomRightCell: string, styleID: number): { error: string | null }
+    SetCellRichText(sheet: string, cell: string, runs: RichTextRun[]): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * SetCellValue provides a function to set the value of a cell. The
      * specified coordinates should not be in the first row of the table, a
      * complex number can be set with string text.
      *
-     * Note that default date format is m/d/yy h:mm of time.Time type value. You
-     * can set numbers format by the SetCellStyle function. If you need to set
+     * Note that default date format is m/d/yy h:mm of Date type value, and the
+     * local date and time of the Date object will be stored. You can set
+     * numbers format by the SetCellStyle function. If you need to set
      * the specialized date in Excel like January 0, 1900 or February 29, 1900,
      * these times can not representation in Go language time.Time data type.
      * Please set the cell value as number 0 or 60, then create and bind the
@@ -3970,47 +4055,41 @@ declare module 'excelize-wasm' {
      * @param cell The cell reference
      * @param value The cell value to be write
      */
-    SetCellValue(sheet: string, cell: string, value: boolean | number | string ): { error: string | null }
-
-    /**
-     * SetColOutlineLevel provides a function to set outline level of a single
-     * column by given worksheet name and column name. The value of parameter
-     * `level` is 1-7.
-     * @param sheet The worksheet name
-     * @param col The column name
-     * @param level The outline level of the column
-     */
-    SetColOutlineLevel(sheet: string, col: string, level: number): { error: string | null }
-
-    /**
-     * SetColStyle provides a function to set style of columns by given
-     * worksheet name, columns range and style ID. Note that this will
-     * overwrite the existing styles for the columns, it won't append or merge
-     * style with existing styles.
-     * @param sheet The worksheet name
-     * @param columns The column range
-     * @param styleID The style ID
-     */
-    SetColStyle(sheet: string, columns: string, styleID: number): { error: string | null }
+    SetCellValue(sheet: string, cell: string, value: boolean | number | bigint | string | Date): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetColVisible provides a function to set visible columns by given
-     * worksheet name, columns range and visibility.
+     * SetCells provides a function to set the values, formulas and styles of
+     * multiple cells in one call by given worksheet name and cell entries.
+     * The entries could be an array of the records with the cell reference,
+     * an object keyed by the cell reference, or a 2D block of the values
+     * anchored at the given top-left cell. Each entry could be a cell value
+     * or a record with value, formula and style ID. The failing entries will
+     * not stop setting the other entries, and the errors of them will be
+     * returned with the cell reference. For example:
+     *
+     * ```typescript
+     * const { errors, error } = f.SetCells('Sheet1', [
+     *   { Cell: 'A1', Value: 'Total' },
+     *   { Cell: 'B1', Formula: 'SUM(B2:B3)', StyleID: style },
+     * ]);
+     * f.SetCells('Sheet1', { A2: 'Apple', B2: 1 });
+     * f.SetCells('Sheet1', 'A3', [['Orange', 2], ['Pear', { Value: 3, StyleID: style }]]);
+     * ```
+     *
      * @param sheet The worksheet name
-     * @param columns The column name
-     * @param visible The column's visibility
+     * @param entries The cell records, or the cell entries keyed by the cell
+     * reference
      */
-    SetColVisible(sheet: string, columns: string, visible: boolean): { error: string | null }
+    SetCells(sheet: string, entries: Array<Cell & { Cell: string }> | { [cell: string]: CellEntry }): { errors: CellEntryError[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetColWidth provides a function to set the width of a single column or
-     * multiple columns.
+     * SetCells provides a function to set the 2D block of the cell entries
+     * anchored at the given top-left cell.
      * @param sheet The worksheet name
-     * @param startCol The start column name
-     * @param endCol The end column name
-     * @param width The width of the column
+     * @param cell The top-left cell reference of the block
+     * @param values The 2D block of the cell entries
      */
-    SetColWidth(sheet: string, startCol: string, endCol: string, width: number): { error: string | null }
+    SetCells(sheet: string, cell: string, values: CellEntry[][]): { errors: CellEntryError[], error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * SetConditionalFormat provides a function to create conditional
@@ -4021,7 +4100,7 @@ declare module 'excelize-wasm' {
      * @param reference The conditional format range reference
      * @param opts The conditional options
      */
-    SetConditionalFormat(sheet: string, reference: string, opts: ConditionalFormatOptions[]): { error: string | null }
+    SetConditionalFormat(sheet: string, reference: string, opts: ConditionalFormatOptions[]): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * SetCustomProps provides a function to set custom file properties by given
@@ -4032,21 +4111,7 @@ declare module 'excelize-wasm' {
      * the correct type.
      * @param prop Custom property of the workbook
      */
-    SetCustomProps(prop: CustomProperty): { error: string | null }
-
-    /**
-     * SetDefaultFont changes the default font in the workbook.
-     * @param fontName The font name
-     */
-    SetDefaultFont(fontName: string): { error: string | null }
-
-    /**
-     * SetDefinedName provides a function to set the defined names of the
-     * workbook or worksheet. If not specified scope, the default scope is
-     * workbook.
-     * @param definedName The name for a cell or cell range on a worksheet
-     */
-    SetDefinedName(definedName: DefinedName): { error: string | null }
+    SetCustomProps(prop: CustomProperty): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
      * SetDocProps provides a function to set document core properties. The
@@ -4117,476 +4182,774 @@ declare module 'excelize-wasm' {
      *
      * @param docProperties The document core properties
      */
-    SetDocProps(docProperties: DocProperties): { error: string | null }
+    SetDocProps(docProperties: DocProperties): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetHeaderFooter provides a function to set headers and footers by given
-     * worksheet name and the control characters.
-     *
-     * Headers and footers are specified using the following settings fields:
-     *
-     *      Fields           | Description
-     *     ------------------+-----------------------------------------------------------
-     *      AlignWithMargins | Align header footer margins with page margins
-     *      DifferentFirst   | Different first-page header and footer indicator
-     *      DifferentOddEven | Different odd and even page headers and footers indicator
-     *      ScaleWithDoc     | Scale header and footer with document scaling
-     *      OddFooter        | Odd Page Footer, or primary Page Footer if
-     *                       | 'DifferentOddEven' is 'false'
-     *      OddHeader        | Odd Header, or primary Page Header if 'DifferentOddEven'
-     *                       | is 'false'
-     *      EvenFooter       | Even Page Footer
-     *      EvenHeader       | Even Page Header
-     *      FirstFooter      | First Page Footer
-     *      FirstHeader      | First Page Header
-     *
-     * The following formatting codes can be used in 6 string type fields:
-     * OddHeader, OddFooter, EvenHeader, EvenFooter, FirstFooter, FirstHeader
-     *
-     *      Formatting Code        | Description
-     *     ------------------------+----------------------------------------------------
-     *      &&                     | The character "&"
-     *                             |
-     *      &font-size             | Size of the text font, where font-size is a decimal
-     *                             | font size in points
-     *                             |
-     *      &"font name,font type" | A text font-name string, font name, and a text
-     *                             | font-type string, font type
-     *                             |
-     *      &"-,Regular"           | Regular text format. Toggles bold and italic modes
-     *                             | to off
-     *                             |
-     *      &A                     | Current worksheet's tab name
-     *                             |
-     *      &B or &"-,Bold"        | Bold text format, from off to on, or vice versa. The
-     *                             | default mode is off
-     *                             |
-     *      &D                     | Current date
-     *                             |
-     *      &C                     | Center section
-     *                             |
-     *      &E                     | Double-underline text format
-     *                             |
-     *      &F                     | Current workbook's file name
-     *                             |
-     *      &G                     | Drawing object as background (Use AddHeaderFooterImage)
-     *                             |
-     *      &H                     | Shadow text format
-     *                             |
-     *      &I or &"-,Italic"      | Italic text format
-     *                             |
-     *      &K                     | Text font color
-     *                             |
-     *                             | An RGB Color is specified as RRGGBB
-     *                             |
-     *                             | A Theme Color is specified as TTSNNN where TT is the
-     *                             | theme color Id, S is either "+" or "-" of the
-     *                             | tint/shade value, and NNN is the tint/shade value
-     *                             |
-     *      &L                     | Left section
-     *                             |
-     *      &N                     | Total number of pages
-     *                             |
-     *      &O                     | Outline text format
-     *                             |
-     *      &P[[+|-]n]             | Without the optional suffix, the current page
-     *                             | number in decimal
-     *                             |
-     *      &R                     | Right section
-     *                             |
-     *      &S                     | Strike through text format
-     *                             |
-     *      &T                     | Current time
-     *                             |
-     *      &U                     | Single-underline text format. If double-underline
-     *                             | mode is on, the next occurrence in a section
-     *                             | specifier toggles double-underline mode to off;
-     *                             | otherwise, it toggles single-underline mode, from
-     *                             | off to on, or vice versa. The default mode is off
-     *                             |
-     *      &X                     | Superscript text format
-     *                             |
-     *      &Y                     | Subscript text format
-     *                             |
-     *      &Z                     | Current workbook's file path
-     *
-     * For example:
+     * SetRecords provides a function to write the records into the worksheet
+     * by given worksheet name, top-left cell reference, array of the records
+     * and the optional records options. The header row will be written with
+     * the column names, and the values of the records will be written below
+     * the header row with the cell types of the values. For example, write
+     * the records as a table with the number format of the Amount column:
      *
      * ```typescript
-     * const { error } = f.SetHeaderFooter('Sheet1', {
-     *   DifferentFirst:   true,
-     *   DifferentOddEven: true,
-     *   OddHeader:        '&R&P',
-     *   OddFooter:        '&C&F',
-     *   EvenHeader:       '&L&P',
-     *   EvenFooter:       '&L&D&R&T',
-     *   FirstHeader:      `&CCenter &"-,Bold"Bold&"-,Regular"HeaderU+000A&D`,
+     * const { error } = f.SetRecords('Sheet1', 'A1', [
+     *     { Name: 'Bob', Amount: 12.5 },
+     *     { Name: 'Amy', Amount: 3 },
+     * ], {
+     *     AsTable: { Name: 'Sales', StyleName: 'TableStyleMedium2' },
+     *     NumberFormats: { Amount: '#,##0.00' },
      * });
      * ```
-     *
-     * This example shows:
-     *
-     * - The first page has its own header and footer
-     *
-     * - Odd and even-numbered pages have different headers and footers
-     *
-     * - Current page number in the right section of odd-page headers
-     *
-     * - Current workbook's file name in the center section of odd-page footers
-     *
-     * - Current page number in the left section of even-page headers
-     *
-     * - Current date in the left section and the current time in the right section
-     * of even-page footers
-     *
-     * - The text "Center Bold Header" on the first line of the center section of
-     * the first page, and the date on the second line of the center section of
-     * that same page
-     *
-     * - No footer on the first page
-     * @param sheet The worksheet name
-     * @param opts The header footer options
-     */
-    SetHeaderFooter(sheet: string, opts: HeaderFooterOptions): { error: string | null }
-
-    /**
-     * SetPageLayout provides a function to sets worksheet page layout. The
-     * following shows the paper size sorted by excelize index number:
-     *
-     *      Index | Paper Size
-     *     -------+-----------------------------------------------
-     *        1   | Letter paper (8.5 in. by 11 in.)
-     *        2   | Letter small paper (8.5 in. by 11 in.)
-     *        3   | Tabloid paper (11 in. by 17 in.)
-     *        4   | Ledger paper (17 in. by 11 in.)
-     *        5   | Legal paper (8.5 in. by 14 in.)
-     *        6   | Statement paper (5.5 in. by 8.5 in.)
-     *        7   | Executive paper (7.25 in. by 10.5 in.)
-     *        8   | A3 paper (297 mm by 420 mm)
-     *        9   | A4 paper (210 mm by 297 mm)
-     *        10  | A4 small paper (210 mm by 297 mm)
-     *        11  | A5 paper (148 mm by 210 mm)
-     *        12  | B4 paper (250 mm by 353 mm)
-     *        13  | B5 paper (176 mm by 250 mm)
-     *        14  | Folio paper (8.5 in. by 13 in.)
-     *        15  | Quarto paper (215 mm by 275 mm)
-     *        16  | Standard paper (10 in. by 14 in.)
-     *        17  | Standard paper (11 in. by 17 in.)
-     *        18  | Note paper (8.5 in. by 11 in.)
-     *        19  | #9 envelope (3.875 in. by 8.875 in.)
-     *        20  | #10 envelope (4.125 in. by 9.5 in.)
-     *        21  | #11 envelope (4.5 in. by 10.375 in.)
-     *        22  | #12 envelope (4.75 in. by 11 in.)
-     *        23  | #14 envelope (5 in. by 11.5 in.)
-     *        24  | C paper (17 in. by 22 in.)
-     *        25  | D paper (22 in. by 34 in.)
-     *        26  | E paper (34 in. by 44 in.)
-     *        27  | DL envelope (110 mm by 220 mm)
-     *        28  | C5 envelope (162 mm by 229 mm)
-     *        29  | C3 envelope (324 mm by 458 mm)
-     *        30  | C4 envelope (229 mm by 324 mm)
-     *        31  | C6 envelope (114 mm by 162 mm)
-     *        32  | C65 envelope (114 mm by 229 mm)
-     *        33  | B4 envelope (250 mm by 353 mm)
-     *        34  | B5 envelope (176 mm by 250 mm)
-     *        35  | B6 envelope (176 mm by 125 mm)
-     *        36  | Italy envelope (110 mm by 230 mm)
-     *        37  | Monarch envelope (3.875 in. by 7.5 in.).
-     *        38  | 6 3/4 envelope (3.625 in. by 6.5 in.)
-     *        39  | US standard fanfold (14.875 in. by 11 in.)
-     *        40  | German standard fanfold (8.5 in. by 12 in.)
-     *        41  | German legal fanfold (8.5 in. by 13 in.)
-     *        42  | ISO B4 (250 mm by 353 mm)
-     *        43  | Japanese postcard (100 mm by 148 mm)
-     *        44  | Standard paper (9 in. by 11 in.)
-     *        45  | Standard paper (10 in. by 11 in.)
-     *        46  | Standard paper (15 in. by 11 in.)
-     *        47  | Invite envelope (220 mm by 220 mm)
-     *        50  | Letter extra paper (9.275 in. by 12 in.)
-     *        51  | Legal extra paper (9.275 in. by 15 in.)
-     *        52  | Tabloid extra paper (11.69 in. by 18 in.)
-     *        53  | A4 extra paper (236 mm by 322 mm)
-     *        54  | Letter transverse paper (8.275 in. by 11 in.)
-     *        55  | A4 transverse paper (210 mm by 297 mm)
-     *        56  | Letter extra transverse paper (9.275 in. by 12 in.)
-     *        57  | SuperA/SuperA/A4 paper (227 mm by 356 mm)
-     *        58  | SuperB/SuperB/A3 paper (305 mm by 487 mm)
-     *        59  | Letter plus paper (8.5 in. by 12.69 in.)
-     *        60  | A4 plus paper (210 mm by 330 mm)
-     *        61  | A5 transverse paper (148 mm by 210 mm)
-     *        62  | JIS B5 transverse paper (182 mm by 257 mm)
-     *        63  | A3 extra paper (322 mm by 445 mm)
-     *        64  | A5 extra paper (174 mm by 235 mm)
-     *        65  | ISO B5 extra paper (201 mm by 276 mm)
-     *        66  | A2 paper (420 mm by 594 mm)
-     *        67  | A3 transverse paper (297 mm by 420 mm)
-     *        68  | A3 extra transverse paper (322 mm by 445 mm)
-     *        69  | Japanese Double Postcard (200 mm x 148 mm)
-     *        70  | A6 (105 mm x 148 mm)
-     *        71  | Japanese Envelope Kaku #2
-     *        72  | Japanese Envelope Kaku #3
-     *        73  | Japanese Envelope Chou #3
-     *        74  | Japanese Envelope Chou #4
-     *        75  | Letter Rotated (11in x 8 1/2 11 in)
-     *        76  | A3 Rotated (420 mm x 297 mm)
-     *        77  | A4 Rotated (297 mm x 210 mm)
-     *        78  | A5 Rotated (210 mm x 148 mm)
-     *        79  | B4 (JIS) Rotated (364 mm x 257 mm)
-     *        80  | B5 (JIS) Rotated (257 mm x 182 mm)
-     *        81  | Japanese Postcard Rotated (148 mm x 100 mm)
-     *        82  | Double Japanese Postcard Rotated (148 mm x 200 mm)
-     *        83  | A6 Rotated (148 mm x 105 mm)
-     *        84  | Japanese Envelope Kaku #2 Rotated
-     *        85  | Japanese Envelope Kaku #3 Rotated
-     *        86  | Japanese Envelope Chou #3 Rotated
-     *        87  | Japanese Envelope Chou #4 Rotated
-     *        88  | B6 (JIS) (128 mm x 182 mm)
-     *        89  | B6 (JIS) Rotated (182 mm x 128 mm)
-     *        90  | (12 in x 11 in)
-     *        91  | Japanese Envelope You #4
-     *        92  | Japanese Envelope You #4 Rotated
-     *        93  | PRC 16K (146 mm x 215 mm)
-     *        94  | PRC 32K (97 mm x 151 mm)
-     *        95  | PRC 32K(Big) (97 mm x 151 mm)
-     *        96  | PRC Envelope #1 (102 mm x 165 mm)
-     *        97  | PRC Envelope #2 (102 mm x 176 mm)
-     *        98  | PRC Envelope #3 (125 mm x 176 mm)
-     *        99  | PRC Envelope #4 (110 mm x 208 mm)
-     *        100 | PRC Envelope #5 (110 mm x 220 mm)
-     *        101 | PRC Envelope #6 (120 mm x 230 mm)
-     *        102 | PRC Envelope #7 (160 mm x 230 mm)
-     *        103 | PRC Envelope #8 (120 mm x 309 mm)
-     *        104 | PRC Envelope #9 (229 mm x 324 mm)
-     *        105 | PRC Envelope #10 (324 mm x 458 mm)
-     *        106 | PRC 16K Rotated
-     *        107 | PRC 32K Rotated
-     *        108 | PRC 32K(Big) Rotated
-     *        109 | PRC Envelope #1 Rotated (165 mm x 102 mm)
-     *        110 | PRC Envelope #2 Rotated (176 mm x 102 mm)
-     *        111 | PRC Envelope #3 Rotated (176 mm x 125 mm)
-     *        112 | PRC Envelope #4 Rotated (208 mm x 110 mm)
-     *        113 | PRC Envelope #5 Rotated (220 mm x 110 mm)
-     *        114 | PRC Envelope #6 Rotated (230 mm x 120 mm)
-     *        115 | PRC Envelope #7 Rotated (230 mm x 160 mm)
-     *        116 | PRC Envelope #8 Rotated (309 mm x 120 mm)
-     *        117 | PRC Envelope #9 Rotated (324 mm x 229 mm)
-     *        118 | PRC Envelope #10 Rotated (458 mm x 324 mm)
-     *
      * @param sheet The worksheet name
-     * @param opts The page layout options
+     * @param cell The top-left cell reference
+     * @param records The array of the records
+     * @param opts The records options
      */
-    SetPageLayout(sheet: string, opts: PageLayoutOptions): { error: string | null }
+    SetRecords(sheet: string, cell: string, records: { [column: string]: boolean | number | bigint | string | Date | null | undefined }[], opts?: SetRecordsOptions): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetPageMargins provides a function to set worksheet page margins.
+     * SetSheetCol writes an array to column by given worksheet name, starting
+     * cell reference and a pointer to array type 'slice'.
      * @param sheet The worksheet name
-     * @param opts The page margin options
+     * @param cell The cell reference
+     * @param slice The column cells to be write
      */
-    SetPageMargins(sheet: string, opts: PageLayoutMarginsOptions): { error: string | null }
+    SetSheetCol(sheet: string, cell: string, slice: Array<boolean | number | bigint | string | Date | null>): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetPanes provides a function to create and remove freeze panes and split
-     * panes by given worksheet name and panes format set.
+     * SetSheetBackgroundFromBytes provides a function to set background picture
+     * by given worksheet name, extension name and image data. Supported image
+     * types: BMP, EMF, EMZ, GIF, ICO, JPEG, JPG, PNG, SVG, TIF, TIFF, WMF, and
+     * WMZ. The Blob and ReadableStream will be read incrementally, and a
+     * promise of the result will be returned for them.
      * @param sheet The worksheet name
-     * @param panes The panes format
+     * @param extension The extension name
+     * @param picture The contents buffer of the file
      */
-    SetPanes(sheet: string, panes: Panes): { error: string | null }
+    SetSheetBackgroundFromBytes(sheet: string, extension: string, picture: BinaryStream): Promise<{ error: string | null, errorInfo?: ErrorInfo }>
+    SetSheetBackgroundFromBytes(sheet: string, extension: string, picture: BinaryData): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetRowHeight provides a function to set the height of a single row. If
-     * the value of height is 0, will hide the specified row, if the value of
-     * height is -1, will unset the custom row height.
+     * SetSheetRow writes an array to row by given worksheet name, starting
+     * cell reference and a pointer to array type 'slice'.
      * @param sheet The worksheet name
-     * @param row The row number
-     * @param height The height of the row
+     * @param cell The starting cell reference
+     * @param slice The array for writes
      */
-    SetRowHeight(sheet: string, row: number, height : number): { error: string | null }
+    SetSheetRow(sheet: string, cell: string, slice: Array<boolean | number | bigint | string | Date | null>): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetRowOutlineLevel provides a function to set outline level number of a
-     * single row by given worksheet name and Excel row number. The value of
-     * parameter `level` is 1-7.
+     * SetSheetVisible provides a function to set worksheet visible by given
+     * worksheet name. A workbook must contain at least one visible worksheet.
+     * If the given worksheet has been activated, this setting will be
+     * invalidated.
      * @param sheet The worksheet name
-     * @param row The row number
-     * @param level The outline level of the row
+     * @param visible The worksheet visibility
      */
-    SetRowOutlineLevel(sheet: string, row: number, level: number): { error: string | null }
+    SetSheetVisible(sheet: string, visible: boolean): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetRowStyle provides a function to set the style of rows by given
-     * worksheet name, row range, and style ID. Note that this will overwrite
-     * the existing styles for the rows, it won't append or merge style with
-     * existing styles.
+     * UnprotectSheet provides a function to remove protection for a sheet,
+     * specified the second optional password parameter to remove sheet
+     * protection with password verification.
      * @param sheet The worksheet name
-     * @param start The start row number
-     * @param end Then end row number
-     * @param styleID The style ID
+     * @param password The password for sheet protection
      */
-    SetRowStyle(sheet: string, start: number, end: number, styleID: number): { error: string | null }
+    UnprotectSheet(sheet: string, password?: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetRowVisible provides a function to set visible of a single row by given
-     * worksheet name and Excel row number. For example, hide row 2 in Sheet1:
+     * UnprotectWorkbook provides a function to remove protection for workbook,
+     * specified the optional password parameter to remove workbook protection
+     * with password verification.
+     * @param password The password for workbook protection
+     */
+    UnprotectWorkbook(password?: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * WriteTo provides a function to write the contents of the workbook to the
+     * given callback function, WritableStream or Node.js writable stream, such
+     * as the fs.WriteStream. The zip archive will be forwarded to the sink in
+     * chunks of up to 64 KiB during the saving, instead of the whole buffer
+     * allocated in memory, except for the encrypted workbook. The callback
+     * function will be called synchronously, and a promise will be returned
+     * for the streams, the stream will be closed after all chunks written, or
+     * aborted on error. The other functions of the workbook return the
+     * 'ERR_WORKBOOK_BUSY' error until the promise settled. For example, save
+     * the workbook to the file in Node.js:
      *
-     * ```typescript
-     * const { error } = f.SetRowVisible('Sheet1', 2, false);
-     * ```
+     * const fs = require('fs');
+     * const { bytes, error } = await f.WriteTo(fs.createWriteStream('Book1.xlsx'));
      *
+     * @param sink The callback function or the stream to receive the chunks
+     * @param opts The options for save the spreadsheet
+     */
+    WriteTo(sink: (chunk: Uint8Array) => void, opts?: ProgressOptions): { bytes: number, error: string | null, errorInfo?: ErrorInfo };
+    WriteTo(sink: WritableStream<Uint8Array> | NodeWritable, opts?: ProgressOptions): Promise<{ bytes: number, error: string | null, errorInfo?: ErrorInfo }>;
+
+    /**
+     * WriteToBuffer provides a function to get the contents buffer from the
+     * saved file, and it allocates space in memory. Be careful when the file
+     * size is large. The workbook is still usable after writing cancelled by
+     * the Signal option.
+     * @param opts The options for save the spreadsheet
+     */
+    WriteToBuffer(opts?: ProgressOptions): { buffer: BlobPart, error: string | null, errorInfo?: ErrorInfo };
+
+    // Code generated by bindgen. DO NOT EDIT.
+
+    /**
+     * AddShape provides the method to add shape in a sheet by given worksheet
+     * name and shape format set (such as offset, scale, aspect ratio setting
+     * and print settings).
      * @param sheet The worksheet name
-     * @param row The row number
-     * @param visible The row's visibility
+     * @param opts The options
      */
-    SetRowVisible(sheet: string, row: number, visible: boolean): { error: string | null }
+    AddShape(sheet: string, opts: Shape): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetSheetCol writes an array to column by given worksheet name, starting
-     * cell reference and a pointer to array type 'slice'.
+     * AddSparkline provides a function to add sparklines to the worksheet by
+     * given formatting options. Sparklines are small charts that fit in a
+     * single cell and are used to show trends in data. Sparklines are a feature
+     * of Excel 2010 and later only. You can write them to workbook that can be
+     * read by Excel 2007, but they won't be displayed. For example, add a
+     * grouped sparkline.
      * @param sheet The worksheet name
-     * @param cell The cell reference
-     * @param slice The column cells to be write
+     * @param opts The options
      */
-    SetSheetCol(sheet: string, cell: string, slice: Array<boolean | number | string>): { error: string | null }
+    AddSparkline(sheet: string, opts: SparklineOptions): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetSheetBackgroundFromBytes provides a function to set background picture
-     * by given worksheet name, extension name and image data. Supported image
-     * types: BMP, EMF, EMZ, GIF, ICO, JPEG, JPG, PNG, SVG, TIF, TIFF, WMF, and
-     * WMZ.
+     * AddTable provides the method to add table in a worksheet by given
+     * worksheet name, range reference and format set.
      * @param sheet The worksheet name
-     * @param extension The extension name
-     * @param picture The contents buffer of the file
+     * @param table The table
      */
-    SetSheetBackgroundFromBytes(sheet: string, extension: string, picture: Uint8Array): { error: string | null }
+    AddTable(sheet: string, table: TableOptions): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetSheetDimension provides the method to set or remove the used range of
-     * the worksheet by a given range reference. It specifies the row and column
-     * bounds of used cells in the worksheet. The range reference is set using
-     * the A1 reference style(e.g., "A1:D5"). Passing an empty range reference
-     * will remove the used range of the worksheet.
+     * AutoFitColWidth provides a function to auto fit columns width according
+     * to their text content with font format. If the selected range contains
+     * hidden columns and those columns have content, this function will unhide
+     * the hidden columns. Note that this function calculates the width of the
+     * text approximately based on the font format, currently does not support
+     * merged cells, the actual width may be different when you open the
+     * workbook in Office applications. This process can be relatively slow on
+     * large worksheets, so this should normally only be called once per column,
+     * at the end of your processing.
      * @param sheet The worksheet name
-     * @param rangeRef The top-left and right-bottom cell range reference
+     * @param columns The column name or range of columns
      */
-    SetSheetDimension(sheet: string, rangeRef: string): { error: string | null }
+    AutoFitColWidth(sheet: string, columns: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetSheetName provides a function to set the worksheet name by given
-     * source and target worksheet names. Maximum 31 characters are allowed in
-     * sheet title and this function only changes the name of the sheet and
-     * will not update the sheet name in the formula or reference associated
-     * with the cell. So there may be problem formula error or reference
-     * missing.
-     * @param source The source sheet name
-     * @param target The target sheet name
+     * CopySheet provides a function to duplicate a worksheet by gave source and
+     * target worksheet index. Note that currently doesn't support duplicate
+     * workbooks that contain tables, charts or pictures.
+     * @param from The from
+     * @param to The to
      */
-    SetSheetName(source: string, target: string): { error: string | null }
+    CopySheet(from: number, to: number): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetSheetProps provides a function to set worksheet properties.
+     * DeleteChart provides a function to delete chart in spreadsheet by given
+     * worksheet name and cell reference.
      * @param sheet The worksheet name
-     * @param opts The worksheet property options
+     * @param cell The cell reference
      */
-    SetSheetProps(sheet: string, opts: SheetPropsOptions): { error: string | null }
+    DeleteChart(sheet: string, cell: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetSheetRow writes an array to row by given worksheet name, starting
-     * cell reference and a pointer to array type 'slice'.
+     * DeleteComment provides the method to delete comment in a worksheet by
+     * given worksheet name and cell reference.
      * @param sheet The worksheet name
-     * @param cell The starting cell reference
-     * @param slice The array for writes
+     * @param cell The cell reference
      */
-    SetSheetRow(sheet: string, cell: string, slice: Array<boolean | number | string | null>): { error: string | null }
+    DeleteComment(sheet: string, cell: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetSheetView sets sheet view options. The viewIndex may be negative and
-     * if so is counted backward (-1 is the last view).
-     * @param sheet The worksheet name
-     * @param viewIndex The sheet view index
-     * @param opts The sheet view options
+     * DeleteDefinedName provides a function to delete the defined names of the
+     * workbook or worksheet. If not specified scope, the default scope is
+     * workbook.
+     * @param definedName The defined name
      */
-    SetSheetView(sheet: string, viewIndex: number, opts: ViewOptions): { error: string | null }
+    DeleteDefinedName(definedName: DefinedName): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetSheetVisible provides a function to set worksheet visible by given
-     * worksheet name. A workbook must contain at least one visible worksheet.
-     * If the given worksheet has been activated, this setting will be
-     * invalidated.
+     * DeleteFormControl provides the method to delete form control in a
+     * worksheet by given worksheet name and cell reference.
      * @param sheet The worksheet name
-     * @param visible The worksheet visibility
+     * @param cell The cell reference
      */
-    SetSheetVisible(sheet: string, visible: boolean): { error: string | null }
+    DeleteFormControl(sheet: string, cell: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * SetWorkbookProps provides a function to sets workbook properties.
-     * @param opts The workbook property options
+     * DeletePicture provides a function to delete all pictures in a cell by
+     * given worksheet name and cell reference.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
      */
-    SetWorkbookProps(opts: WorkbookPropsOptions): { error: string | null }
+    DeletePicture(sheet: string, cell: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * UngroupSheets provides a function to ungroup worksheets.
+     * DeletePivotTable delete a pivot table by giving the worksheet name and
+     * pivot table name. Note that this function does not clean cell values in
+     * the pivot table range.
+     * @param sheet The worksheet name
+     * @param name The pivot table name
      */
-    UngroupSheets(): { error: string | null }
+    DeletePivotTable(sheet: string, name: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * UnmergeCell provides a function to unmerge a given range reference. For
-     * example unmerge range reference D3:E9 on Sheet1:
-     *
-     * ```typescript
-     * const { error } = f.UnmergeCell('Sheet1', 'D3', 'E9');
-     * ```
-     *
-     * Attention: overlapped range will also be unmerged.
+     * DeleteSheet provides a function to delete worksheet in a workbook by
+     * given worksheet name. Use this method with caution, which will affect
+     * changes in references such as formulas, charts, and so on. If there is
+     * any referenced value of the deleted worksheet, it will cause a file error
+     * when you open it. This function will be invalid when only one worksheet
+     * is left.
      * @param sheet The worksheet name
-     * @param topLeftCell The top-left cell reference
-     * @param bottomRightCell The right-bottom cell reference
      */
-    UnmergeCell(sheet: string, topLeftCell: string, bottomRightCell: string): { error: string | null }
+    DeleteSheet(sheet: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * UnprotectSheet provides a function to remove protection for a sheet,
-     * specified the second optional password parameter to remove sheet
-     * protection with password verification.
-     * @param sheet The worksheet name
-     * @param password The password for sheet protection
+     * DeleteSlicer provides the method to delete a slicer by a given slicer
+     * name.
+     * @param name The slicer name
      */
-    UnprotectSheet(sheet: string, password?: string): { error: string | null }
+    DeleteSlicer(name: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * UnprotectWorkbook provides a function to remove protection for workbook,
-     * specified the optional password parameter to remove workbook protection
-     * with password verification.
-     * @param password The password for workbook protection
+     * DeleteTable provides the method to delete table by given table name.
+     * @param name The table name
      */
-    UnprotectWorkbook(password?: string): { error: string | null }
+    DeleteTable(name: string): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * UnsetConditionalFormat provides a function to unset the conditional
-     * format by given worksheet name and range reference.
+     * DuplicateRow inserts a copy of specified row (by its Excel row number)
+     * below
      * @param sheet The worksheet name
-     * @param reference The conditional format range reference
+     * @param row The row number
      */
-    UnsetConditionalFormat(sheet: string, reference: string): { error: string | null }
+    DuplicateRow(sheet: string, row: number): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * UpdateLinkedValue fix linked values within a spreadsheet are not
-     * updating in Office Excel application. This function will be remove
-     * value tag when met a cell have a linked value.
+     * DuplicateRowTo inserts a copy of specified row by it Excel number to
+     * specified row position moving down exists rows after target position
+     * @param sheet The worksheet name
+     * @param row The row number
+     * @param row2 The row2
      */
-    UpdateLinkedValue(): { error: string | null }
+    DuplicateRowTo(sheet: string, row: number, row2: number): { error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * WriteToBuffer provides a function to get the contents buffer from the
-     * saved file, and it allocates space in memory. Be careful when the file
-     * size is large.
-     * @param opts The options for save the spreadsheet
+     * GetActiveSheetIndex provides a function to get active sheet index of the
+     * spreadsheet. If not found the active sheet will be return integer 0.
      */
-    WriteToBuffer(opts?: Options): { buffer: BlobPart, error: string | null };
+    GetActiveSheetIndex(): { index: number, error: string | null, errorInfo?: ErrorInfo }
 
     /**
-     * Error message
+     * GetCellFormula provides a function to get formula from cell by given
+     * worksheet name and cell reference in spreadsheet.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
      */
-    error?: string | null;
-  }
+    GetCellFormula(sheet: string, cell: string): { formula: string, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetCellStyle provides a function to get cell style index by given
+     * worksheet name and cell reference. This function is concurrency safe.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     */
+    GetCellStyle(sheet: string, cell: string): { style: number, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetColOutlineLevel provides a function to get outline level of a single
+     * column by given worksheet name and column name.
+     * @param sheet The worksheet name
+     * @param col The column name
+     */
+    GetColOutlineLevel(sheet: string, col: string): { level: number, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetColStyle provides a function to get column style ID by given worksheet
+     * name and column name. This function is concurrency safe.
+     * @param sheet The worksheet name
+     * @param col The column name
+     */
+    GetColStyle(sheet: string, col: string): { style: number, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetColVisible provides a function to get visible of a single column by
+     * given worksheet name and column name. This function is concurrency safe.
+     * @param sheet The worksheet name
+     * @param col The column name
+     */
+    GetColVisible(sheet: string, col: string): { visible: boolean, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetRowOutlineLevel provides a function to get outline level number of a
+     * single row by given worksheet name and Excel row number.
+     * @param sheet The worksheet name
+     * @param row The row number
+     */
+    GetRowOutlineLevel(sheet: string, row: number): { level: number, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetRowVisible provides a function to get visible of a single row by given
+     * worksheet name and Excel row number.
+     * @param sheet The worksheet name
+     * @param row The row number
+     */
+    GetRowVisible(sheet: string, row: number): { visible: boolean, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetSheetIndex provides a function to get a sheet index of the workbook by
+     * the given sheet name. If the given sheet name is invalid or sheet doesn't
+     * exist, it will return an integer type value -1.
+     * @param sheet The worksheet name
+     */
+    GetSheetIndex(sheet: string): { index: number, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * GetSheetName provides a function to get the sheet name of the workbook by
+     * the given sheet index. If the given sheet index is invalid, it will
+     * return an empty string.
+     * @param index The index
+     */
+    GetSheetName(index: number): { name: string, error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * InsertCols provides a function to insert new columns before the given
+     * column name and number of columns.
+     * @param sheet The worksheet name
+     * @param col The column name
+     * @param n The n
+     */
+    InsertCols(sheet: string, col: string, n: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * InsertPageBreak create a page break to determine where the printed page
+     * ends and where begins the next one by given worksheet name and cell
+     * reference, so the content before the page break will be printed on one
+     * page and after the page break on another.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     */
+    InsertPageBreak(sheet: string, cell: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * InsertRows provides a function to insert new rows after the given Excel
+     * row number starting from 1 and number of rows.
+     * @param sheet The worksheet name
+     * @param row The row number
+     * @param n The n
+     */
+    InsertRows(sheet: string, row: number, n: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * MergeCell provides a function to merge cells by given range reference and
+     * sheet name. Merging cells only keeps the upper-left cell value, and
+     * discards the other values.
+     * @param sheet The worksheet name
+     * @param topLeftCell The top left cell
+     * @param bottomRightCell The bottom right cell
+     */
+    MergeCell(sheet: string, topLeftCell: string, bottomRightCell: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * MoveSheet moves a sheet to a specified position in the workbook. The
+     * function moves the source sheet before the target sheet. After moving,
+     * other sheets will be shifted to the left or right. If the sheet is
+     * already at the target position, the function will not perform any action.
+     * Not that this function will be ungroup all sheets after moving.
+     * @param source The source
+     * @param target The target
+     */
+    MoveSheet(source: string, target: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * ProtectSheet provides a function to prevent other users from accidentally
+     * or deliberately changing, moving, or deleting data in a worksheet. The
+     * optional field AlgorithmName specified hash algorithm, support XOR, MD4,
+     * MD5, SHA-1, SHA2-56, SHA-384, and SHA-512 currently, if no hash algorithm
+     * specified, will be using the XOR algorithm as default.
+     * @param sheet The worksheet name
+     * @param opts The options
+     */
+    ProtectSheet(sheet: string, opts: SheetProtectionOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * ProtectWorkbook provides a function to prevent other users from viewing
+     * hidden worksheets, adding, moving, deleting, or hiding worksheets, and
+     * renaming worksheets in a workbook. The optional field AlgorithmName
+     * specified hash algorithm, support XOR, MD4, MD5, SHA-1, SHA2-56, SHA-384,
+     * and SHA-512 currently, if no hash algorithm specified, will be using the
+     * XOR algorithm as default. The generated workbook only works on Microsoft
+     * Office 2007 and later.
+     * @param opts The options
+     */
+    ProtectWorkbook(opts: WorkbookProtectionOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * RemoveCol provides a function to remove single column by given worksheet
+     * name and column index.
+     * @param sheet The worksheet name
+     * @param col The column name
+     */
+    RemoveCol(sheet: string, col: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * RemovePageBreak remove a page break by given worksheet name and cell
+     * reference.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     */
+    RemovePageBreak(sheet: string, cell: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * RemoveRow provides a function to remove single row by given worksheet
+     * name and Excel row number.
+     * @param sheet The worksheet name
+     * @param row The row number
+     */
+    RemoveRow(sheet: string, row: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetActiveSheet provides a function to set the default active sheet of the
+     * workbook by a given index. Note that the active index is different from
+     * the ID returned by function GetSheetMap(). It should be greater than or
+     * equal to 0 and less than the total worksheet numbers.
+     * @param index The index
+     */
+    SetActiveSheet(index: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetAppProps provides a function to set document application properties.
+     * @param appProperties The app properties
+     */
+    SetAppProps(appProperties: AppProperties): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCalcProps provides a function to sets calculation properties. Optional
+     * value of "CalcMode" property is: "manual", "auto" or "autoNoTable".
+     * Optional value of "RefMode" property is: "A1" or "R1C1".
+     * @param opts The options
+     */
+    SetCalcProps(opts: CalcPropsOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellBool provides a function to set bool type value of a cell by given
+     * worksheet name, cell reference and cell value.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     * @param value The value
+     */
+    SetCellBool(sheet: string, cell: string, value: boolean): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellDefault provides a function to set string type value of a cell as
+     * default format without escaping the cell.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     * @param value The value
+     */
+    SetCellDefault(sheet: string, cell: string, value: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellFloat sets a floating point value into a cell. The precision
+     * parameter specifies how many places after the decimal will be shown while
+     * -1 is a special value that will use as many decimal places as necessary
+     * to represent the number. bitSize is 32 or 64 depending on if a float32 or
+     * float64 was originally used for the value.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     * @param value The value
+     * @param precision The precision
+     * @param bitSize The bit size
+     */
+    SetCellFloat(sheet: string, cell: string, value: number, precision: number, bitSize: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellFormula provides a function to set formula on the cell is taken
+     * according to the given worksheet name and cell formula settings. The
+     * result of the formula cell can be calculated when the worksheet is opened
+     * by the Office Excel application or can be using the "CalcCellValue"
+     * function also can get the calculated cell value. If the Excel application
+     * doesn't calculate the formula automatically when the workbook has been
+     * opened, please call "UpdateLinkedValue" after setting the cell formula
+     * functions.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     * @param formula The formula
+     * @param opts The options
+     */
+    SetCellFormula(sheet: string, cell: string, formula: string, opts?: FormulaOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellHyperLink provides a function to set cell hyperlink by given
+     * worksheet name and link URL address. LinkType defines three types of
+     * hyperlink "External" for website or "Location" for moving to one of cell
+     * in this workbook or "None" for remove hyperlink. Maximum limit hyperlinks
+     * in a worksheet is 65530. This function is only used to set the hyperlink
+     * of the cell and doesn't affect the value of the cell. If you need to set
+     * the value of the cell, please use the other functions such as
+     * `SetCellStyle` or `SetSheetRow`. The below is example for external link.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     * @param link The link
+     * @param linkType The link type
+     * @param opts The options
+     */
+    SetCellHyperLink(sheet: string, cell: string, link: string, linkType: string, opts?: HyperlinkOpts): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellInt provides a function to set int type value of a cell by given
+     * worksheet name, cell reference and cell value.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     * @param value The value
+     */
+    SetCellInt(sheet: string, cell: string, value: number | bigint): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellStr provides a function to set string type value of a cell. Total
+     * number of characters that a cell can contain 32767 characters.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     * @param value The value
+     */
+    SetCellStr(sheet: string, cell: string, value: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellStyle provides a function to add style attribute for cells by
+     * given worksheet name, range reference and style ID. This function is
+     * concurrency safe. Note that diagonalDown and diagonalUp type border
+     * should be use same color in the same range. SetCellStyle will overwrite
+     * the existing styles for the cell, it won't append or merge style with
+     * existing styles.
+     * @param sheet The worksheet name
+     * @param topLeftCell The top left cell
+     * @param bottomRightCell The bottom right cell
+     * @param styleID The style i d
+     */
+    SetCellStyle(sheet: string, topLeftCell: string, bottomRightCell: string, styleID: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetCellUint provides a function to set uint type value of a cell by given
+     * worksheet name, cell reference and cell value.
+     * @param sheet The worksheet name
+     * @param cell The cell reference
+     * @param value The value
+     */
+    SetCellUint(sheet: string, cell: string, value: number | bigint): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetColOutlineLevel provides a function to set outline level of a single
+     * column by given worksheet name and column name. The value of parameter
+     * 'level' is 1-7.
+     * @param sheet The worksheet name
+     * @param col The column name
+     * @param level The level
+     */
+    SetColOutlineLevel(sheet: string, col: string, level: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetColStyle provides a function to set style of columns by given
+     * worksheet name, columns range and style ID. This function is concurrency
+     * safe. Note that this will overwrite the existing styles for the columns,
+     * it won't append or merge style with existing styles.
+     * @param sheet The worksheet name
+     * @param columns The column name or range of columns
+     * @param styleID The style i d
+     */
+    SetColStyle(sheet: string, columns: string, styleID: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetColVisible provides a function to set visible columns by given
+     * worksheet name, columns range and visibility. This function is
+     * concurrency safe.
+     * @param sheet The worksheet name
+     * @param columns The column name or range of columns
+     * @param visible The visible
+     */
+    SetColVisible(sheet: string, columns: string, visible: boolean): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetColWidth provides a function to set the width of a single column or
+     * multiple columns. This function is concurrency safe.
+     * @param sheet The worksheet name
+     * @param startCol The start col
+     * @param endCol The end col
+     * @param width The width
+     */
+    SetColWidth(sheet: string, startCol: string, endCol: string, width: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetDefaultFont changes the default font in the workbook.
+     * @param fontName The font name
+     */
+    SetDefaultFont(fontName: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetDefinedName provides a function to set the defined names of the
+     * workbook or worksheet. If not specified scope, the default scope is
+     * workbook.
+     * @param definedName The defined name
+     */
+    SetDefinedName(definedName: DefinedName): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetHeaderFooter provides a function to set headers and footers by given
+     * worksheet name and the control characters.
+     * @param sheet The worksheet name
+     * @param opts The options
+     */
+    SetHeaderFooter(sheet: string, opts: HeaderFooterOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetPageLayout provides a function to sets worksheet page layout.
+     * @param sheet The worksheet name
+     * @param opts The options
+     */
+    SetPageLayout(sheet: string, opts: PageLayoutOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetPageMargins provides a function to set worksheet page margins.
+     * @param sheet The worksheet name
+     * @param opts The options
+     */
+    SetPageMargins(sheet: string, opts: PageLayoutMarginsOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetPanes provides a function to create and remove freeze panes and split
+     * panes by given worksheet name and panes options.
+     * @param sheet The worksheet name
+     * @param panes The panes
+     */
+    SetPanes(sheet: string, panes: Panes): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetRowHeight provides a function to set the height of a single row. If
+     * the value of height is 0, will hide the specified row, if the value of
+     * height is -1, will unset the custom row height.
+     * @param sheet The worksheet name
+     * @param row The row number
+     * @param height The height
+     */
+    SetRowHeight(sheet: string, row: number, height: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetRowOutlineLevel provides a function to set outline level number of a
+     * single row by given worksheet name and row number. The range of 'level'
+     * parameter value from 1 to 7.
+     * @param sheet The worksheet name
+     * @param row The row number
+     * @param level The level
+     */
+    SetRowOutlineLevel(sheet: string, row: number, level: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetRowStyle provides a function to set the style of rows by given
+     * worksheet name, row range, and style ID. Note that this will overwrite
+     * the existing styles for the rows, it won't append or merge style with
+     * existing styles.
+     * @param sheet The worksheet name
+     * @param start The start
+     * @param end The end
+     * @param styleID The style i d
+     */
+    SetRowStyle(sheet: string, start: number, end: number, styleID: number): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetRowVisible provides a function to set visible of a single row by given
+     * worksheet name and Excel row number.
+     * @param sheet The worksheet name
+     * @param row The row number
+     * @param visible The visible
+     */
+    SetRowVisible(sheet: string, row: number, visible: boolean): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetSheetDimension provides the method to set or remove the used range of
+     * the worksheet by a given range reference. It specifies the row and column
+     * bounds of used cells in the worksheet. The range reference is set using
+     * the A1 reference style(e.g., "A1:D5"). Passing an empty range reference
+     * will remove the used range of the worksheet.
+     * @param sheet The worksheet name
+     * @param rangeRef The range ref
+     */
+    SetSheetDimension(sheet: string, rangeRef: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetSheetName provides a function to set the worksheet name by given
+     * source and target worksheet names. Maximum 31 characters are allowed in
+     * sheet title and this function only changes the name of the sheet and will
+     * not update the sheet name in the formula or reference associated with the
+     * cell. So there may be problem formula error or reference missing.
+     * @param source The source
+     * @param target The target
+     */
+    SetSheetName(source: string, target: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetSheetProps provides a function to set worksheet properties.
+     * @param sheet The worksheet name
+     * @param opts The options
+     */
+    SetSheetProps(sheet: string, opts: SheetPropsOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetSheetView sets sheet view options. The viewIndex may be negative and
+     * if so is counted backward (-1 is the last view).
+     * @param sheet The worksheet name
+     * @param viewIndex The view index
+     * @param opts The options
+     */
+    SetSheetView(sheet: string, viewIndex: number, opts: ViewOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * SetWorkbookProps provides a function to sets workbook properties.
+     * @param opts The options
+     */
+    SetWorkbookProps(opts: WorkbookPropsOptions): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * UngroupSheets provides a function to ungroup worksheets.
+     */
+    UngroupSheets(): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * UnmergeCell provides a function to unmerge a given range reference.
+     * @param sheet The worksheet name
+     * @param topLeftCell The top left cell
+     * @param bottomRightCell The bottom right cell
+     */
+    UnmergeCell(sheet: string, topLeftCell: string, bottomRightCell: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * UnsetConditionalFormat provides a function to unset the conditional
+     * format by given worksheet name and range reference.
+     * @param sheet The worksheet name
+     * @param rangeRef The range ref
+     */
+    UnsetConditionalFormat(sheet: string, rangeRef: string): { error: string | null, errorInfo?: ErrorInfo }
+
+    /**
+     * UpdateLinkedValue fix linked values within a spreadsheet are not updating
+     * in Office Excel application. This function will be remove value tag when
+     * met a cell have a linked value. Reference
+     * https://learn.microsoft.com/en-us/archive/msdn-technet-forums/e16bae1f-6a2c-4325-8013-e989a3479066
+     */
+    UpdateLinkedValue(): { error: string | null, errorInfo?: ErrorInfo }
+
+    // End of code generated by bindgen.
+
+    /**
+     * Error message
+     */
+    error?: string | null;
+  }
 
   /**
    * init provides a function to compile and instantiate WebAssembly code by a
@@ -4605,6 +4968,9 @@ declare module 'excelize-wasm' {
     ThemeColor:                                       typeof ThemeColor,
     NewFile:                                          typeof NewFile;
     OpenReader:                                       typeof OpenReader;
+    SetThrowOnError:                                  typeof SetThrowOnError;
+    SetStrictMode:                                    typeof SetStrictMode;
+    ExcelizeError:                                    typeof ExcelizeError;
     CellTypeUnset:                                    typeof CellType.CellTypeUnset;
     CellTypeBool:                                     typeof CellType.CellTypeBool;
     CellTypeDate:                                     typeof CellType.CellTypeDate;
@@ -4744,4 +5110,78 @@ declare module 'excelize-wasm' {
     PivotTableShowValuesAsRankLargestToSmallest:      typeof PivotTableShowValuesAsType.PivotTableShowValuesAsRankLargestToSmallest;
     PivotTableShowValuesAsIndex:                      typeof PivotTableShowValuesAsType.PivotTableShowValuesAsIndex;
   }>;
+
+  /**
+   * Cloneable represents the given argument type which could be sent to the
+   * worker, the functions and AbortSignal can't be sent, so the callback
+   * options such as OnProgress, Signal and the function CharsetReader, and
+   * the callback of WriteTo are not supported by the promise-based proxy.
+   */
+  export type Cloneable<T> =
+    T extends ((...args: any[]) => any) | AbortSignal ? never :
+    T extends Uint8Array | ArrayBuffer | Blob | ReadableStream | WritableStream | Date | string | number | bigint | boolean | null | undefined ? T :
+    T extends Array<infer U> ? Array<Cloneable<U>> :
+    T extends object ? { [K in keyof T]: Cloneable<T[K]> } : T;
+
+  /**
+   * Async represents the promise-based proxy of the given type, all the
+   * methods of the proxy return a promise which resolves the result of the
+   * method in the worker.
+   */
+  export type Async<T> =
+    T extends (...args: infer A) => infer R ? (...args: { [K in keyof A]: Cloneable<A[K]> }) => Promise<Async<R>> :
+    T extends PromiseLike<infer U> ? Async<U> :
+    T extends Uint8Array | Date | string | number | boolean | null | undefined ? T :
+    T extends Array<infer U> ? Array<Async<U>> :
+    T extends AsyncIterable<infer U> ? AsyncIterable<U> & { [K in Exclude<keyof T, typeof Symbol.asyncIterator>]: Async<T[K]> } :
+    T extends object ? { [K in keyof T]: Async<T[K]> } : T;
+
+  /**
+   * initWorker provides a function to compile and instantiate WebAssembly
+   * code by a given compressed wasm archive path in a dedicated Web Worker
+   * (or worker thread in Node.js), and returns the promise-based proxy of the
+   * functions, all the workbook operations will be running in the worker
+   * without blocking the caller's thread. The Uint8Array buffers, the
+   * ArrayBuffer and the ReadableStream in the arguments and the buffers in the
+   * results will be transferred between the threads without copying, note
+   * that the transferred objects will be detached and can't be used by the
+   * sender anymore. The functions and AbortSignal can't be sent to the
+   * worker, the call will be rejected with a TypeError if any of its
+   * arguments is or contains them, such as the OnProgress, Signal and the
+   * function CharsetReader options, and the callback of WriteTo. The
+   * workbooks and iterators will be closed in the worker after their proxies
+   * garbage collected. For example:
+   *
+   * ```typescript
+   * const { initWorker } = require('excelize-wasm');
+   *
+   * initWorker('./node_modules/excelize-wasm/excelize.wasm.gz').then(async (excelize) => {
+   *   const f = await excelize.OpenReader(buffer);
+   *   if (f.error) {
+   *     console.log(f.error);
+   *     return;
+   *   }
+   *   const { result, error } = await f.GetRows('Sheet1');
+   *   if (error) {
+   *     console.log(error);
+   *     return;
+   *   }
+   *   console.log(result);
+   *   await f.Close();
+   *   excelize.terminate();
+   * });
+   * ```
+   *
+   * @param path The compressed wasm archive path
+   * @param workerPath The worker script path, default is the worker.js in
+   * the same directory of this module
+   */
+  export function initWorker(path: string, workerPath?: string | URL): Promise<
+    Async<Awaited<ReturnType<typeof init>>> & {
+      /**
+       * terminate stops the worker, and rejects all the pending calls.
+       */
+      terminate(): Promise<number> | void;
+    }
+  >;
 }
@@ -587,10 +587,54 @@ if (node && typeof globalThis.navigator === 'undefined') {
 
 import { ungzip } from 'pako';
 
+// The helper functions used by the WebAssembly, which are defined statically
+// instead of created by the Function constructor at runtime, to work with the
+// Content-Security-Policy without 'unsafe-eval'.
+const helpers = {
+  // resultStub returns the stub function which returns a copy of the fixed
+  // result, or throws the result if it's an ExcelizeError, and calls the
+  // closed stub function after the workbook closed.
+  resultStub: (value, workbook, closed, ExcelizeError) => function () {
+    if (workbook.closed) {
+      return Reflect.apply(closed, this, arguments);
+    }
+    if (value instanceof ExcelizeError) {
+      throw value;
+    }
+    return value !== null && typeof value === 'object' ? Object.assign({}, value) : value;
+  },
+  // throwIfError returns the function which calls the given function and
+  // throws the returned ExcelizeError in the exception mode.
+  throwIfError: (fn, ExcelizeError) => function () {
+    const ret = Reflect.apply(fn, this, arguments);
+    if (ret instanceof ExcelizeError) {
+      throw ret;
+    }
+    return ret;
+  },
+  // nodeEvent returns a promise which will be fulfilled on the given event of
+  // the Node.js stream, or be rejected on the error event.
+  nodeEvent: (emitter, event) => new Promise((resolve, reject) => {
+    const onError = (err) => {
+      emitter.removeListener(event, onEvent);
+      reject(err);
+    };
+    const onEvent = () => {
+      emitter.removeListener('error', onError);
+      resolve();
+    };
+    emitter.once(event, onEvent);
+    emitter.once('error', onError);
+  }),
+  // noop does nothing, which is used as the error listener of the Node.js
+  // stream to avoid the unhandled error event crashes the process.
+  noop: () => {},
+};
+
 export async function init(wasmPath) {
   const go = new Go();
   let buffer;
-  globalThis.excelize = {};
+  globalThis.excelize = { helpers };
   if (node) {
     const mod = await dynamicImport('node:fs').catch(() => dynamicImport('fs'));
     const fs = mod.default || mod;
@@ -605,3 +649,156 @@ export async function init(wasmPath) {
   go.run(result.instance);
   return excelize;
 };
+
+// The property name of the encoded object which marks it as a handle of the
+// object lives in the worker, and the method name for getting the async
+// iterator of the handle.
+const handleKey = '__excelizeHandle';
+const asyncIteratorMethod = '@@asyncIterator';
+
+// transferList appends the buffers of the typed arrays, the array buffers,
+// the readable and writable streams in the arguments to the transfer list,
+// these objects will be detached after posted to the worker.
+const transferList = (value, transfer) => {
+  if (value === null || typeof value !== 'object' || value instanceof Date) {
+    return transfer;
+  }
+  if (typeof Blob !== 'undefined' && value instanceof Blob) {
+    return transfer;
+  }
+  if (value instanceof ArrayBuffer || (typeof ReadableStream !== 'undefined' && value instanceof ReadableStream) ||
+    (typeof WritableStream !== 'undefined' && value instanceof WritableStream)) {
+    if (!transfer.includes(value)) {
+      transfer.push(value);
+    }
+    return transfer;
+  }
+  if (ArrayBuffer.isView(value)) {
+    if (value.byteOffset === 0 && value.byteLength === value.buffer.byteLength && !transfer.includes(value.buffer)) {
+      transfer.push(value.buffer);
+    }
+    return transfer;
+  }
+  Object.values(value).forEach((item) => transferList(item, transfer));
+  return transfer;
+};
+
+// unclonablePath returns the path of the first function or AbortSignal in the
+// argument, which can't be posted to the worker, it returns undefined if the
+// argument could be posted.
+const unclonablePath = (value, path, seen) => {
+  if (typeof value === 'function' || (typeof AbortSignal !== 'undefined' && value instanceof AbortSignal)) {
+    return path;
+  }
+  if (value === null || typeof value !== 'object' || value instanceof Date || value instanceof ArrayBuffer ||
+    ArrayBuffer.isView(value) || seen.has(value)) {
+    return undefined;
+  }
+  seen.add(value);
+  for (const key of Object.keys(value)) {
+    const found = unclonablePath(value[key], Array.isArray(value) ? `${path}[${key}]` : path ? `${path}.${key}` : key, seen);
+    if (found !== undefined) {
+      return found;
+    }
+  }
+  return undefined;
+};
+
+export async function initWorker(wasmPath, workerPath) {
+  const url = workerPath || new URL('./worker.js', import.meta.url);
+  const pending = new Map();
+  let nextID = 0;
+  let worker;
+  const receive = ({ id, result, error }) => {
+    const { resolve, reject, owner } = pending.get(id);
+    pending.delete(id);
+    if (error) {
+      const { message, ...props } = error;
+      const err = new Error(message);
+      Object.keys(props).forEach((key) => props[key] !== undefined && (err[key] = props[key]));
+      reject(err);
+      return;
+    }
+    resolve(decode(result, owner));
+  };
+  const fail = (err) => {
+    pending.forEach(({ reject }) => reject(err));
+    pending.clear();
+  };
+  if (node) {
+    const { Worker } = await dynamicImport('node:worker_threads').catch(() => dynamicImport('worker_threads'));
+    worker = new Worker(url);
+    worker.on('message', receive);
+    worker.on('error', fail);
+    worker.unref();
+  } else {
+    worker = new Worker(url, { type: 'module' });
+    worker.onmessage = (event) => receive(event.data);
+    worker.onerror = (event) => fail(new Error(event.message));
+  }
+  const post = (message, transfer, owner) => new Promise((resolve, reject) => {
+    const id = nextID++;
+    pending.set(id, { resolve, reject, owner });
+    node && worker.ref();
+    worker.postMessage({ id, ...message }, transfer);
+  }).finally(() => node && !pending.size && worker.unref());
+  // The functions and AbortSignal can't be posted to the worker, so the
+  // callback options such as OnProgress, Signal and the function
+  // CharsetReader, and the callback of WriteTo are rejected before posting.
+  const call = (owner, handle, method, args) => {
+    for (let i = 0; i < args.length; i++) {
+      const path = unclonablePath(args[i], '', new Set());
+      if (path !== undefined) {
+        const err = new TypeError(`${method}: the ${path ? `${path} option` : 'callback'} of argument ${i} is a function or AbortSignal, which can't be sent to the worker`);
+        return Promise.reject(Object.assign(err, { argIndex: i, path }));
+      }
+    }
+    return post({ handle, method, args }, transferList(args, []), owner);
+  };
+  // Close and release the handles in the worker after the proxy objects
+  // garbage collected, the workbooks and iterators not closed will be closed.
+  const registry = typeof FinalizationRegistry !== 'undefined' &&
+    new FinalizationRegistry((release) => worker.postMessage({ release }));
+  // The objects returned by the methods of a proxy object keep the reference
+  // to it, so the workbook won't be closed while its iterators in use.
+  const ownerKey = Symbol('owner');
+  const decode = (value, owner) => {
+    if (value === null || typeof value !== 'object' || value instanceof Date || ArrayBuffer.isView(value)) {
+      return value;
+    }
+    if (Array.isArray(value)) {
+      return value.map((item) => decode(item, owner));
+    }
+    if (value[handleKey] === undefined) {
+      return Object.fromEntries(Object.entries(value).map(([key, item]) => [key, decode(item, owner)]));
+    }
+    const handle = value[handleKey];
+    const obj = decode(value.props, owner);
+    if (owner) {
+      Object.defineProperty(obj, ownerKey, { value: owner });
+    }
+    value.methods.forEach((method) => {
+      obj[method] = (...args) => call(obj, handle, method, args);
+    });
+    if (value.asyncIterable) {
+      obj[Symbol.asyncIterator] = () => {
+        const iterator = call(obj, handle, asyncIteratorMethod, []);
+        return {
+          next: () => iterator.then((it) => it.next()),
+          return: () => iterator.then((it) => it.return()),
+        };
+      };
+    }
+    registry && registry.register(obj, handle);
+    return obj;
+  };
+  if (!node && globalThis.location) {
+    wasmPath = new URL(wasmPath, globalThis.location.href).href;
+  }
+  const excelize = await post({ wasmPath }, []);
+  excelize.terminate = () => {
+    fail(new Error('worker terminated'));
+    return worker.terminate();
+  };
+  return excelize;
+};
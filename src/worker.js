@@ -0,0 +1,97 @@
+import { init } from './index.js';
+
+const node = typeof process !== 'undefined' && process.versions && process.versions.node;
+const dynamicImport = (name) => Function('m', 'return import(m)')(name);
+
+// The property name of the encoded object which marks it as a handle of the
+// object lives in the worker, and the method name for getting the async
+// iterator of the handle.
+const handleKey = '__excelizeHandle';
+const asyncIteratorMethod = '@@asyncIterator';
+
+const handles = new Map();
+let nextHandle = 1;
+
+// encode converts the value returned by the WebAssembly functions into a
+// structured cloneable value. The objects with methods, such as workbook,
+// merged cells, stream writer and iterators, are kept in the worker and
+// replaced with handles. The buffers of the typed arrays will be appended to
+// the transfer list to avoid copying.
+const encode = (value, transfer) => {
+  if (value === null || typeof value !== 'object' || value instanceof Date) {
+    return value;
+  }
+  if (ArrayBuffer.isView(value)) {
+    if (value.byteOffset === 0 && value.byteLength === value.buffer.byteLength && !transfer.includes(value.buffer)) {
+      transfer.push(value.buffer);
+    }
+    return value;
+  }
+  if (Array.isArray(value)) {
+    return value.map((item) => encode(item, transfer));
+  }
+  const methods = [];
+  const props = {};
+  for (const key of Object.keys(value)) {
+    if (typeof value[key] === 'function') {
+      methods.push(key);
+      continue;
+    }
+    props[key] = encode(value[key], transfer);
+  }
+  const asyncIterable = typeof value[Symbol.asyncIterator] === 'function';
+  if (!methods.length && !asyncIterable) {
+    return props;
+  }
+  const handle = nextHandle++;
+  handles.set(handle, value);
+  return { [handleKey]: handle, methods, asyncIterable, props };
+};
+
+// call invokes the method of the object by given handle, the async iterator
+// of the object will be returned for the asyncIteratorMethod, and the promise
+// returned by the method will be awaited.
+const call = async (handle, method, args) => {
+  const obj = handles.get(handle);
+  if (obj === undefined) {
+    throw new Error('invalid handle');
+  }
+  if (method === asyncIteratorMethod) {
+    return obj[Symbol.asyncIterator]();
+  }
+  return obj[method](...args);
+};
+
+const receive = async (post, { id, wasmPath, release, handle, method, args }) => {
+  if (release !== undefined) {
+    // Close the workbook or iterator of the garbage collected handle to
+    // release its resources in the WebAssembly
+    const obj = handles.get(release);
+    handles.delete(release);
+    if (obj && typeof obj.Close === 'function') {
+      try {
+        await obj.Close();
+      } catch {
+        // The workbook or iterator has been closed
+      }
+    }
+    return;
+  }
+  try {
+    const result = wasmPath !== undefined ? await init(wasmPath) : await call(handle, method, args);
+    const transfer = [];
+    post({ id, result: encode(result, transfer) }, transfer);
+  } catch (err) {
+    const { name, code, argIndex, path } = err || {};
+    post({ id, error: { message: err && err.message !== undefined ? err.message : String(err), name, code, argIndex, path } });
+  }
+};
+
+if (node) {
+  const { parentPort } = await dynamicImport('node:worker_threads').catch(() => dynamicImport('worker_threads'));
+  const post = (message, transfer) => parentPort.postMessage(message, transfer);
+  parentPort.on('message', (message) => receive(post, message));
+} else {
+  const post = (message, transfer) => self.postMessage(message, transfer);
+  self.onmessage = (event) => receive(post, event.data);
+}